@@ -0,0 +1,3 @@
+data "cosmo_api_key" "test" {
+  name = var.name
+}
\ No newline at end of file
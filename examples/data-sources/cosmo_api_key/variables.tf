@@ -0,0 +1,4 @@
+variable "name" {
+  type        = string
+  description = "The name of the API key to retrieve"
+}
\ No newline at end of file
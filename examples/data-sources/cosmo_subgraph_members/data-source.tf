@@ -0,0 +1,4 @@
+data "cosmo_subgraph_members" "test" {
+  subgraph_name = var.subgraph_name
+  namespace     = var.namespace
+}
@@ -0,0 +1,9 @@
+variable "subgraph_name" {
+  type        = string
+  description = "The name of the subgraph to retrieve the members of"
+}
+
+variable "namespace" {
+  type        = string
+  description = "The namespace of the subgraph"
+}
@@ -0,0 +1,2 @@
+# API keys can be imported using their name. The secret value of an imported key is not known.
+terraform import cosmo_api_key.example my-api-key
\ No newline at end of file
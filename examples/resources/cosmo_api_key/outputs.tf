@@ -0,0 +1,8 @@
+output "id" {
+  value = cosmo_api_key.test.id
+}
+
+output "key" {
+  value     = cosmo_api_key.test.key
+  sensitive = true
+}
\ No newline at end of file
@@ -0,0 +1,4 @@
+resource "cosmo_api_key" "test" {
+  name    = var.name
+  expires = var.expires
+}
\ No newline at end of file
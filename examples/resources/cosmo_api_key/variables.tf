@@ -0,0 +1,8 @@
+variable "name" {
+  type = string
+}
+
+variable "expires" {
+  type    = string
+  default = "never"
+}
\ No newline at end of file
@@ -0,0 +1,11 @@
+output "federated_graph_name" {
+  value = cosmo_apollo_migration.example.federated_graph_name
+}
+
+output "subgraph_names" {
+  value = cosmo_apollo_migration.example.subgraph_names
+}
+
+output "import_blocks" {
+  value = cosmo_apollo_migration.example.import_blocks
+}
@@ -0,0 +1,5 @@
+resource "cosmo_apollo_migration" "example" {
+  api_key   = var.apollo_api_key
+  graph_ref = var.graph_ref
+  namespace = var.namespace
+}
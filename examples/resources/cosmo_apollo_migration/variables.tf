@@ -0,0 +1,13 @@
+variable "apollo_api_key" {
+  type      = string
+  sensitive = true
+}
+
+variable "graph_ref" {
+  type = string
+}
+
+variable "namespace" {
+  type    = string
+  default = "default"
+}
@@ -0,0 +1,2 @@
+# Event-driven subgraphs can be imported using the namespace and the name of the subgraph.
+terraform import cosmo_event_driven_subgraph.example default/my-subgraph
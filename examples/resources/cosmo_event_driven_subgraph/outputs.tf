@@ -0,0 +1,15 @@
+output "id" {
+  value = cosmo_event_driven_subgraph.example.id
+}
+
+output "kafka_topics" {
+  value = cosmo_event_driven_subgraph.example.kafka_topics
+}
+
+output "nats_subjects" {
+  value = cosmo_event_driven_subgraph.example.nats_subjects
+}
+
+output "redis_channels" {
+  value = cosmo_event_driven_subgraph.example.redis_channels
+}
@@ -0,0 +1,8 @@
+resource "cosmo_event_driven_subgraph" "example" {
+  name      = var.name
+  namespace = var.namespace
+  schema    = file("${path.module}/schema.graphql")
+  labels = {
+    "team" = "backend"
+  }
+}
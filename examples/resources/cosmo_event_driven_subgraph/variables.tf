@@ -0,0 +1,7 @@
+variable "name" {
+  type = string
+}
+
+variable "namespace" {
+  type = string
+}
@@ -0,0 +1,2 @@
+# The state of feature flags can be imported using the namespace and the name of the feature flag.
+terraform import cosmo_feature_flag_state.example default.my-feature-flag
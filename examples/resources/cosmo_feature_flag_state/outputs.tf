@@ -0,0 +1,7 @@
+output "id" {
+  value = cosmo_feature_flag_state.example.id
+}
+
+output "enabled" {
+  value = cosmo_feature_flag_state.example.enabled
+}
@@ -0,0 +1,5 @@
+resource "cosmo_feature_flag_state" "example" {
+  name      = var.name
+  namespace = var.namespace
+  enabled   = var.enabled
+}
@@ -0,0 +1,11 @@
+variable "name" {
+  type = string
+}
+
+variable "namespace" {
+  type = string
+}
+
+variable "enabled" {
+  type = bool
+}
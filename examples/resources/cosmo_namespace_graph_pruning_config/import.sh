@@ -0,0 +1,2 @@
+# Graph pruning configs can be imported using the name of their namespace
+terraform import cosmo_namespace_graph_pruning_config.example my-namespace
\ No newline at end of file
@@ -0,0 +1,19 @@
+resource "cosmo_namespace_graph_pruning_config" "test" {
+  namespace = var.namespace
+  enabled   = true
+  rules = {
+    UNUSED_FIELDS = {
+      severity                          = "warn"
+      grace_period_in_days              = 7
+      schema_usage_check_period_in_days = 7
+    }
+    DEPRECATED_FIELDS = {
+      severity             = "warn"
+      grace_period_in_days = 30
+    }
+    REQUIRE_DEPRECATION_BEFORE_DELETION = {
+      severity             = "error"
+      grace_period_in_days = 7
+    }
+  }
+}
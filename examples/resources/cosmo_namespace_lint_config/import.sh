@@ -0,0 +1,2 @@
+# Lint configs can be imported using the name of their namespace
+terraform import cosmo_namespace_lint_config.example my-namespace
\ No newline at end of file
@@ -0,0 +1,3 @@
+output "id" {
+  value = cosmo_namespace_lint_config.test.id
+}
\ No newline at end of file
@@ -0,0 +1,9 @@
+resource "cosmo_namespace_lint_config" "test" {
+  namespace = var.namespace
+  enabled   = true
+  rules = {
+    FIELD_NAMES_SHOULD_BE_CAMEL_CASE = "warn"
+    TYPE_NAMES_SHOULD_BE_PASCAL_CASE = "warn"
+    REQUIRE_DEPRECATION_REASON       = "error"
+  }
+}
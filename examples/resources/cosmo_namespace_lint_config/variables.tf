@@ -0,0 +1,3 @@
+variable "namespace" {
+  type = string
+}
\ No newline at end of file
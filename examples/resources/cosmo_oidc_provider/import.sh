@@ -0,0 +1,2 @@
+# The OIDC provider of the organization can be imported using its name.
+terraform import cosmo_oidc_provider.example my-oidc-provider
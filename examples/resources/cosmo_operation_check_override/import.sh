@@ -0,0 +1,2 @@
+# Operation check overrides can be imported using the namespace, the federated graph name and the operation hash.
+terraform import cosmo_operation_check_override.example default/my-graph/4a7f3c1e9b2d8f6a0c5e7b3d1f9a2c4e6b8d0f1a3c5e7b9d2f4a6c8e0b1d3f5a
@@ -0,0 +1,3 @@
+output "id" {
+  value = cosmo_operation_check_override.test.id
+}
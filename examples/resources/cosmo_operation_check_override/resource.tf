@@ -0,0 +1,20 @@
+resource "cosmo_operation_check_override" "test" {
+  graph_name     = var.graph_name
+  namespace      = var.namespace
+  operation_hash = var.operation_hash
+  operation_name = "Employees"
+
+  changes = [
+    {
+      change_type = "FIELD_REMOVED"
+      path        = "Employee.legacyId"
+    },
+  ]
+}
+
+resource "cosmo_operation_check_override" "retired_client" {
+  graph_name     = var.graph_name
+  namespace      = var.namespace
+  operation_hash = var.retired_operation_hash
+  ignore_all     = true
+}
@@ -0,0 +1,15 @@
+variable "graph_name" {
+  type = string
+}
+
+variable "namespace" {
+  type = string
+}
+
+variable "operation_hash" {
+  type = string
+}
+
+variable "retired_operation_hash" {
+  type = string
+}
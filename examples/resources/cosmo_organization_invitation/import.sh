@@ -0,0 +1,2 @@
+# Organization invitations can be imported using the email of the invited user.
+terraform import cosmo_organization_invitation.example jane@example.com
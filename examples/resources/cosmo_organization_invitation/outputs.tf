@@ -0,0 +1,7 @@
+output "id" {
+  value = cosmo_organization_invitation.test.id
+}
+
+output "status" {
+  value = cosmo_organization_invitation.test.status
+}
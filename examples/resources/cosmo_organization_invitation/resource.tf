@@ -0,0 +1,3 @@
+resource "cosmo_organization_invitation" "test" {
+  email = var.email
+}
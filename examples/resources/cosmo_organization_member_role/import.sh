@@ -0,0 +1,2 @@
+# Organization member roles can be imported using the email of the member.
+terraform import cosmo_organization_member_role.example jane@example.com
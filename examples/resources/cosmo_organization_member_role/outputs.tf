@@ -0,0 +1,7 @@
+output "id" {
+  value = cosmo_organization_member_role.test.id
+}
+
+output "user_id" {
+  value = cosmo_organization_member_role.test.user_id
+}
@@ -0,0 +1,4 @@
+resource "cosmo_organization_member_role" "test" {
+  email = var.email
+  role  = var.role
+}
@@ -0,0 +1,8 @@
+variable "email" {
+  type = string
+}
+
+variable "role" {
+  type    = string
+  default = "developer"
+}
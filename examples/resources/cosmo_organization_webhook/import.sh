@@ -0,0 +1,2 @@
+# Organization webhooks can be imported using their id
+terraform import cosmo_organization_webhook.example 0b1b1b1b-1b1b-1b1b-1b1b-1b1b1b1b1b1b
\ No newline at end of file
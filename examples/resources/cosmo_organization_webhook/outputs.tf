@@ -0,0 +1,3 @@
+output "id" {
+  value = cosmo_organization_webhook.test.id
+}
\ No newline at end of file
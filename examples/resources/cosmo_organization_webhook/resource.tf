@@ -0,0 +1,6 @@
+resource "cosmo_organization_webhook" "test" {
+  endpoint            = var.endpoint
+  key                 = var.key
+  events              = ["FEDERATED_GRAPH_SCHEMA_UPDATED"]
+  federated_graph_ids = var.federated_graph_ids
+}
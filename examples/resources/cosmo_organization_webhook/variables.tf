@@ -0,0 +1,13 @@
+variable "endpoint" {
+  type = string
+}
+
+variable "key" {
+  type      = string
+  sensitive = true
+}
+
+variable "federated_graph_ids" {
+  type    = list(string)
+  default = []
+}
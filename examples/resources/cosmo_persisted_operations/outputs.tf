@@ -0,0 +1,7 @@
+output "id" {
+  value = cosmo_persisted_operations.test.id
+}
+
+output "operations" {
+  value = cosmo_persisted_operations.test.operations
+}
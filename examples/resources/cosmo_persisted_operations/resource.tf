@@ -0,0 +1,7 @@
+resource "cosmo_persisted_operations" "test" {
+  graph_name  = var.graph_name
+  namespace   = var.namespace
+  client_name = var.client_name
+  documents   = [for file in fileset(path.module, "operations/*.graphql") : file("${path.module}/${file}")]
+  manifest    = file("${path.module}/persisted-query-manifest.json")
+}
@@ -0,0 +1,11 @@
+variable "graph_name" {
+  type = string
+}
+
+variable "namespace" {
+  type = string
+}
+
+variable "client_name" {
+  type = string
+}
@@ -0,0 +1,2 @@
+# Playground scripts can be imported using their id.
+terraform import cosmo_playground_script.example 2b3c6a1e-5d6f-4e7a-9b8c-0d1e2f3a4b5c
@@ -0,0 +1,3 @@
+output "id" {
+  value = cosmo_playground_script.test.id
+}
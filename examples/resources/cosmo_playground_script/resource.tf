@@ -0,0 +1,15 @@
+resource "cosmo_playground_script" "test" {
+  title   = var.title
+  type    = "pre-flight"
+  content = <<-EOT
+    const response = await fetch("https://auth.example.com/token");
+    const { token } = await response.json();
+    playground.env.set("token", token);
+  EOT
+}
+
+resource "cosmo_playground_script" "from_file" {
+  title        = "${var.title} (from file)"
+  type         = "pre-operation"
+  content_file = "${path.module}/scripts/set-auth-header.js"
+}
@@ -0,0 +1,2 @@
+const token = playground.env.get("token");
+playground.request.headers.set("Authorization", `Bearer ${token}`);
@@ -0,0 +1,2 @@
+# Subgraph members can be imported using the namespace, the subgraph name and the email of the user.
+terraform import cosmo_subgraph_member.example default/my-subgraph/jane@example.com
@@ -0,0 +1,5 @@
+resource "cosmo_subgraph_member" "test" {
+  subgraph_name = var.subgraph_name
+  namespace     = var.namespace
+  email         = var.email
+}
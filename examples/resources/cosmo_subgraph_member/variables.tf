@@ -0,0 +1,11 @@
+variable "subgraph_name" {
+  type = string
+}
+
+variable "namespace" {
+  type = string
+}
+
+variable "email" {
+  type = string
+}
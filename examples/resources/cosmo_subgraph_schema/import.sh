@@ -0,0 +1,2 @@
+# Subgraph schemas can be imported using the namespace and the name of the subgraph.
+terraform import cosmo_subgraph_schema.example default/my-subgraph
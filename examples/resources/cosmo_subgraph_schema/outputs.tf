@@ -0,0 +1,7 @@
+output "id" {
+  value = cosmo_subgraph_schema.test.id
+}
+
+output "schema" {
+  value = cosmo_subgraph_schema.test.schema
+}
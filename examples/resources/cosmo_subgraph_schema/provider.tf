@@ -0,0 +1,9 @@
+terraform {
+  required_providers {
+    cosmo = {
+      source  = "terraform.local/wundergraph/cosmo"
+      version = "0.0.1"
+    }
+  }
+}
+
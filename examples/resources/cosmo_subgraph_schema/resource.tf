@@ -0,0 +1,14 @@
+resource "cosmo_subgraph" "test" {
+  name        = var.subgraph_name
+  namespace   = var.namespace
+  routing_url = var.routing_url
+}
+
+resource "cosmo_subgraph_schema" "test" {
+  subgraph_name       = cosmo_subgraph.test.name
+  namespace           = cosmo_subgraph.test.namespace
+  schema              = file("${path.module}/schema.graphql")
+  composition_failure = "warn"
+  on_destroy          = "publish_baseline"
+  baseline_schema     = file("${path.module}/baseline.graphql")
+}
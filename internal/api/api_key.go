@@ -0,0 +1,62 @@
+package api
+
+import (
+	"context"
+
+	"github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/common"
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+)
+
+const (
+	ApiKeyExpiresNever      = "never"
+	ApiKeyExpiresThirtyDays = "30_days"
+	ApiKeyExpiresSixMonths  = "6_months"
+	ApiKeyExpiresOneYear    = "1_year"
+)
+
+func ResolveApiKeyExpiration(expires string) platformv1.ExpiresAt {
+	switch expires {
+	case ApiKeyExpiresThirtyDays:
+		return platformv1.ExpiresAt_THIRTY_DAYS
+	case ApiKeyExpiresSixMonths:
+		return platformv1.ExpiresAt_SIX_MONTHS
+	case ApiKeyExpiresOneYear:
+		return platformv1.ExpiresAt_ONE_YEAR
+	// ApiKeyExpiresNever
+	default:
+		return platformv1.ExpiresAt_NEVER
+	}
+}
+
+// CreateApiKey creates the key and returns its secret value, which the
+// platform does not reveal again afterwards.
+func (p *PlatformClient) CreateApiKey(ctx context.Context, data *platformv1.CreateAPIKeyRequest) (string, *ApiError) {
+	response, apiError := invoke(ctx, p, "CreateApiKey", write, p.Client.CreateAPIKey, data)
+	if apiError != nil {
+		return "", apiError
+	}
+
+	return response.ApiKey, nil
+}
+
+func (p *PlatformClient) GetApiKey(ctx context.Context, name string) (*platformv1.APIKey, *ApiError) {
+	response, apiError := invoke(ctx, p, "GetApiKey", read, p.Client.GetAPIKeys, &platformv1.GetAPIKeysRequest{})
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	for _, apiKey := range response.ApiKeys {
+		if apiKey.Name == name {
+			return apiKey, nil
+		}
+	}
+
+	return nil, &ApiError{Err: ErrNotFound, Reason: "GetApiKey", Status: common.EnumStatusCode_ERR_NOT_FOUND}
+}
+
+func (p *PlatformClient) DeleteApiKey(ctx context.Context, name string) *ApiError {
+	_, apiError := invoke(ctx, p, "DeleteApiKey", write, p.Client.DeleteAPIKey, &platformv1.DeleteAPIKeyRequest{
+		Name: name,
+	})
+	return apiError
+}
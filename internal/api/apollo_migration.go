@@ -0,0 +1,101 @@
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+)
+
+// ApolloMigration is the result of migrating a graph from Apollo GraphOS.
+type ApolloMigration struct {
+	// Token is the router token of the migrated federated graph.
+	Token string
+	// FederatedGraph is nil if the migrated graph could not be identified.
+	FederatedGraph *platformv1.FederatedGraph
+	Subgraphs      []*platformv1.Subgraph
+}
+
+// MigrateFromApollo migrates the variant of the Apollo graph the API key
+// belongs to into the namespace. The platform only returns the router token,
+// the migrated federated graph is identified by the id the token was issued
+// for, or by the name of the Apollo graph if the token does not tell. Once
+// the migration succeeded, the migration is returned even if the graph could
+// not be identified, without FederatedGraph and Subgraphs and with the error
+// of reading the graph, if any.
+func (p *PlatformClient) MigrateFromApollo(ctx context.Context, apiKey, graphName, variantName, namespace string) (*ApolloMigration, *ApiError) {
+	response, apiError := p.migrateFromApollo(ctx, apiKey, variantName, namespace)
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	migration := &ApolloMigration{Token: response.GetToken()}
+
+	// The graph is read past the read cache, whose graphs don't carry their
+	// subgraphs.
+	if id := graphIdFromToken(response.GetToken()); id != "" {
+		migrated, apiError := p.fetchFederatedGraphById(ctx, "GetFederatedGraphById", id)
+		if apiError == nil {
+			migration.FederatedGraph, migration.Subgraphs = migrated.GetGraph(), migrated.GetSubgraphs()
+		}
+		return migration, ignoreNotFound(apiError)
+	}
+
+	if graphName != "" {
+		migrated, apiError := p.fetchFederatedGraph(ctx, "GetFederatedGraph", graphName, namespace)
+		if apiError == nil {
+			migration.FederatedGraph, migration.Subgraphs = migrated.GetGraph(), migrated.GetSubgraphs()
+		}
+		return migration, ignoreNotFound(apiError)
+	}
+
+	return migration, nil
+}
+
+func ignoreNotFound(apiError *ApiError) *ApiError {
+	if apiError != nil && IsNotFoundError(apiError) {
+		return nil
+	}
+	return apiError
+}
+
+// graphIdFromToken returns the id of the federated graph a router token was
+// issued for. Router tokens are JWTs carrying the id as the
+// federated_graph_id claim, the signature is not verified.
+func graphIdFromToken(token string) string {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return ""
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return ""
+	}
+
+	var claims struct {
+		FederatedGraphId string `json:"federated_graph_id"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return ""
+	}
+
+	return claims.FederatedGraphId
+}
+
+func (p *PlatformClient) migrateFromApollo(ctx context.Context, apiKey, variantName, namespace string) (*platformv1.MigrateFromApolloResponse, *ApiError) {
+	unlock, lockErr := p.compositions.lock(ctx, namespace)
+	if lockErr != nil {
+		return nil, lockErr
+	}
+	defer unlock()
+	defer p.cache.invalidate(namespace)
+
+	return invoke(ctx, p, "MigrateFromApollo", write, p.Client.MigrateFromApollo, &platformv1.MigrateFromApolloRequest{
+		ApiKey:      apiKey,
+		VariantName: variantName,
+		Namespace:   namespace,
+	})
+}
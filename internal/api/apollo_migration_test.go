@@ -0,0 +1,122 @@
+package api_test
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/fake"
+)
+
+func newFakeApolloGraph() *fake.PlatformServer {
+	server := fake.NewPlatformServer()
+	server.AddFederatedGraph(&platformv1.FederatedGraph{Id: "existing-id", Name: "existing", Namespace: "default"})
+	server.AddApolloGraph("apollo_key", "current",
+		&platformv1.FederatedGraph{Id: "graph-id", Name: "products"},
+		&platformv1.Subgraph{Id: "inventory-id", Name: "inventory"},
+		&platformv1.Subgraph{Id: "reviews-id", Name: "reviews"},
+	)
+
+	return server
+}
+
+func TestMigrateFromApollo(t *testing.T) {
+	server := newFakeApolloGraph()
+	client, err := api.NewClient("api_key", server.Start(t))
+	if err != nil {
+		t.Fatalf("Expected client to be created but got error: %v", err)
+	}
+
+	migration, apiErr := client.MigrateFromApollo(context.Background(), "apollo_key", "apollo-graph", "current", "default")
+	if apiErr != nil {
+		t.Fatalf("Expected graph to be migrated but got error: %v", apiErr)
+	}
+
+	if migration.FederatedGraph.GetName() != "products" || migration.FederatedGraph.GetId() != "graph-id" {
+		t.Errorf("Expected the migrated graph products, got: %v", migration.FederatedGraph)
+	}
+	if migration.Token != fake.RouterToken("graph-id") {
+		t.Errorf("Expected the router token of the migrated graph, got: %s", migration.Token)
+	}
+	if len(migration.Subgraphs) != 2 {
+		t.Errorf("Expected 2 migrated subgraphs, got: %v", migration.Subgraphs)
+	}
+}
+
+func TestMigrateFromApolloInvalidVariant(t *testing.T) {
+	server := newFakeApolloGraph()
+	client, err := api.NewClient("api_key", server.Start(t))
+	if err != nil {
+		t.Fatalf("Expected client to be created but got error: %v", err)
+	}
+
+	if _, apiErr := client.MigrateFromApollo(context.Background(), "apollo_key", "products", "staging", "default"); apiErr == nil {
+		t.Fatal("Expected the migration of an unknown variant to fail")
+	}
+
+	if calls := server.Calls("GetFederatedGraphByName"); calls != 0 {
+		t.Errorf("Expected no migrated graph to be read, got: %d", calls)
+	}
+}
+
+func TestMigrateFromApolloIdentifiesGraphByName(t *testing.T) {
+	server := newFakeApolloGraph()
+	server.SetOpaqueRouterTokens()
+	client, err := api.NewClient("api_key", server.Start(t))
+	if err != nil {
+		t.Fatalf("Expected client to be created but got error: %v", err)
+	}
+
+	migration, apiErr := client.MigrateFromApollo(context.Background(), "apollo_key", "products", "current", "default")
+	if apiErr != nil {
+		t.Fatalf("Expected graph to be migrated but got error: %v", apiErr)
+	}
+
+	if migration.FederatedGraph.GetId() != "graph-id" {
+		t.Errorf("Expected the migrated graph products, got: %v", migration.FederatedGraph)
+	}
+	if len(migration.Subgraphs) != 2 {
+		t.Errorf("Expected 2 migrated subgraphs, got: %v", migration.Subgraphs)
+	}
+}
+
+func TestMigrateFromApolloUnidentifiedGraph(t *testing.T) {
+	server := newFakeApolloGraph()
+	server.SetOpaqueRouterTokens()
+	client, err := api.NewClient("api_key", server.Start(t))
+	if err != nil {
+		t.Fatalf("Expected client to be created but got error: %v", err)
+	}
+
+	migration, apiErr := client.MigrateFromApollo(context.Background(), "apollo_key", "apollo-graph", "current", "default")
+	if apiErr != nil {
+		t.Fatalf("Expected graph to be migrated but got error: %v", apiErr)
+	}
+
+	if migration.Token != "token-products" {
+		t.Errorf("Expected the router token of the migrated graph, got: %s", migration.Token)
+	}
+	if migration.FederatedGraph != nil || len(migration.Subgraphs) != 0 {
+		t.Errorf("Expected the migrated graph to be unknown, got: %v", migration.FederatedGraph)
+	}
+}
+
+func TestMigrateFromApolloKeepsMigrationOnReadError(t *testing.T) {
+	server := newFakeApolloGraph()
+	server.Fail("GetFederatedGraphById", connect.CodeUnavailable)
+	client, err := api.NewClient("api_key", server.Start(t))
+	if err != nil {
+		t.Fatalf("Expected client to be created but got error: %v", err)
+	}
+
+	migration, apiErr := client.MigrateFromApollo(context.Background(), "apollo_key", "products", "current", "default")
+	if apiErr == nil {
+		t.Fatal("Expected the error of reading the migrated graph")
+	}
+	if migration == nil || migration.Token != fake.RouterToken("graph-id") {
+		t.Fatalf("Expected the migration to be returned, got: %v", migration)
+	}
+}
@@ -0,0 +1,245 @@
+package api
+
+import (
+	"context"
+	"sync"
+
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// readCache keeps the graphs, subgraphs and schemas read from the platform in
+// memory. It is shared by all resources of a provider instance. On first
+// access to a namespace, its graphs and subgraphs are loaded at once through
+// the GetFederatedGraphs and GetSubgraphs list endpoints, so a refresh reads a
+// namespace with two round trips instead of one per resource. Schemas have no
+// list endpoint and are kept after their first read.
+//
+// The list endpoints don't return the readme of graphs and subgraphs, objects
+// served from the cache carry a nil readme. The resources keep the readme of
+// their state in that case, changes to a readme made outside of Terraform are
+// only detected with the cache disabled.
+//
+// Mutations which change graphs or subgraphs drop the cached objects of their
+// namespace, as composition may change graphs beyond the one that was mutated.
+// Other mutations, e.g. creating an API key, leave the cache intact.
+//
+// Objects are handed out as copies, callers are free to modify them.
+//
+// A nil *readCache is valid and behaves as a disabled cache.
+type readCache struct {
+	mu         sync.Mutex
+	namespaces map[string]*namespaceEntry
+}
+
+type namespaceEntry struct {
+	// ready is closed once the load finished. graphs and subgraphs are not
+	// modified afterwards.
+	ready     chan struct{}
+	loaded    bool
+	graphs    map[string]*platformv1.FederatedGraph
+	subgraphs map[string]*platformv1.Subgraph
+
+	mu      sync.Mutex
+	schemas map[string]string
+}
+
+func newReadCache() *readCache {
+	return &readCache{
+		namespaces: map[string]*namespaceEntry{},
+	}
+}
+
+// invalidate drops the cached objects of the namespaces.
+func (c *readCache) invalidate(namespaces ...string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, namespace := range namespaces {
+		delete(c.namespaces, namespace)
+	}
+}
+
+// namespace returns the loaded entry of the namespace, loading it on first
+// access. Concurrent callers wait for the same load. It returns nil if the
+// cache is disabled or the namespace could not be loaded, in which case the
+// caller falls back to the direct RPC.
+//
+// Reads use the entry that was current when they started. If a mutation
+// invalidates the namespace in the meantime, the entry is already detached
+// and its objects are never served to later reads.
+func (p *PlatformClient) namespace(ctx context.Context, namespace string) *namespaceEntry {
+	c := p.cache
+	if c == nil {
+		return nil
+	}
+
+	c.mu.Lock()
+	entry, found := c.namespaces[namespace]
+	if !found {
+		entry = &namespaceEntry{ready: make(chan struct{}), schemas: map[string]string{}}
+		c.namespaces[namespace] = entry
+	}
+	c.mu.Unlock()
+
+	if !found {
+		entry.loaded = p.loadNamespace(ctx, entry, namespace)
+		close(entry.ready)
+
+		if !entry.loaded {
+			// Drop the failed load, the next access tries again.
+			c.mu.Lock()
+			if c.namespaces[namespace] == entry {
+				delete(c.namespaces, namespace)
+			}
+			c.mu.Unlock()
+		}
+	} else {
+		select {
+		case <-entry.ready:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+
+	if !entry.loaded {
+		return nil
+	}
+
+	return entry
+}
+
+func (p *PlatformClient) loadNamespace(ctx context.Context, entry *namespaceEntry, namespace string) bool {
+	// A limit of 0 returns all objects of the namespace.
+	graphs, apiError := invoke(ctx, p, "GetFederatedGraphs", read, p.Client.GetFederatedGraphs, &platformv1.GetFederatedGraphsRequest{
+		Namespace: namespace,
+	})
+	if apiError != nil {
+		return false
+	}
+
+	subgraphs, apiError := invoke(ctx, p, "GetSubgraphs", read, p.Client.GetSubgraphs, &platformv1.GetSubgraphsRequest{
+		Namespace: namespace,
+	})
+	if apiError != nil {
+		return false
+	}
+
+	entry.graphs = make(map[string]*platformv1.FederatedGraph, len(graphs.GetGraphs()))
+	for _, graph := range graphs.GetGraphs() {
+		entry.graphs[graph.GetName()] = graph
+	}
+
+	entry.subgraphs = make(map[string]*platformv1.Subgraph, len(subgraphs.GetGraphs()))
+	for _, subgraph := range subgraphs.GetGraphs() {
+		// Feature subgraphs are left to the direct lookup, which resolves
+		// their base subgraph.
+		if subgraph.GetIsFeatureSubgraph() {
+			continue
+		}
+		entry.subgraphs[subgraph.GetName()] = subgraph
+	}
+
+	return true
+}
+
+// loaded returns the namespaces which finished loading, without waiting for
+// loads in flight.
+func (c *readCache) loaded() []*namespaceEntry {
+	if c == nil {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entries := make([]*namespaceEntry, 0, len(c.namespaces))
+	for _, entry := range c.namespaces {
+		select {
+		case <-entry.ready:
+			if entry.loaded {
+				entries = append(entries, entry)
+			}
+		default:
+		}
+	}
+
+	return entries
+}
+
+func (c *readCache) federatedGraphById(id string) (*platformv1.FederatedGraph, bool) {
+	for _, entry := range c.loaded() {
+		for _, graph := range entry.graphs {
+			if graph.GetId() == id {
+				return proto.Clone(graph).(*platformv1.FederatedGraph), true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+func (c *readCache) subgraphById(id string) (*platformv1.Subgraph, bool) {
+	for _, entry := range c.loaded() {
+		for _, subgraph := range entry.subgraphs {
+			if subgraph.GetId() == id {
+				return proto.Clone(subgraph).(*platformv1.Subgraph), true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+func (e *namespaceEntry) federatedGraph(name string) (*platformv1.FederatedGraph, bool) {
+	if e == nil {
+		return nil, false
+	}
+
+	graph, ok := e.graphs[name]
+	if !ok {
+		return nil, false
+	}
+
+	return proto.Clone(graph).(*platformv1.FederatedGraph), true
+}
+
+func (e *namespaceEntry) subgraph(name string) (*platformv1.Subgraph, bool) {
+	if e == nil {
+		return nil, false
+	}
+
+	subgraph, ok := e.subgraphs[name]
+	if !ok {
+		return nil, false
+	}
+
+	return proto.Clone(subgraph).(*platformv1.Subgraph), true
+}
+
+func (e *namespaceEntry) subgraphSchema(name string) (string, bool) {
+	if e == nil {
+		return "", false
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	schema, ok := e.schemas[name]
+	return schema, ok
+}
+
+func (e *namespaceEntry) setSubgraphSchema(name, schema string) {
+	if e == nil {
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.schemas[name] = schema
+}
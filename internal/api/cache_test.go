@@ -0,0 +1,339 @@
+package api_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"connectrpc.com/connect"
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/fake"
+)
+
+const fakeNamespace = "default"
+
+func newFakeServer(subgraphs int) *fake.PlatformServer {
+	server := fake.NewPlatformServer()
+	server.AddFederatedGraph(&platformv1.FederatedGraph{
+		Id:        "graph-id",
+		Name:      "graph",
+		Namespace: fakeNamespace,
+	})
+
+	for i := 0; i < subgraphs; i++ {
+		name := fmt.Sprintf("subgraph-%d", i)
+		server.AddSubgraph(&platformv1.Subgraph{
+			Id:        name + "-id",
+			Name:      name,
+			Namespace: fakeNamespace,
+		}, fmt.Sprintf("type Query { field%d: String }", i))
+	}
+
+	return server
+}
+
+func TestReadCacheLoadsNamespaceOnce(t *testing.T) {
+	server := newFakeServer(3)
+	client, err := api.NewClient("api_key", server.Start(t))
+	if err != nil {
+		t.Fatalf("Expected client to be created but got error: %v", err)
+	}
+
+	ctx := context.Background()
+	for n := 0; n < 2; n++ {
+		for i := 0; i < 3; i++ {
+			subgraph, apiErr := client.GetSubgraph(ctx, fmt.Sprintf("subgraph-%d", i), fakeNamespace)
+			if apiErr != nil {
+				t.Fatalf("Expected subgraph to be returned but got error: %v", apiErr)
+			}
+			if subgraph.GetId() != fmt.Sprintf("subgraph-%d-id", i) {
+				t.Errorf("Expected subgraph-%d-id, got: %s", i, subgraph.GetId())
+			}
+		}
+	}
+
+	if _, apiErr := client.GetSubgraphById(ctx, "subgraph-0-id"); apiErr != nil {
+		t.Fatalf("Expected subgraph to be returned but got error: %v", apiErr)
+	}
+	if _, apiErr := client.GetFederatedGraphById(ctx, "graph-id"); apiErr != nil {
+		t.Fatalf("Expected federated graph to be returned but got error: %v", apiErr)
+	}
+	if _, apiErr := client.GetFederatedGraph(ctx, "graph", fakeNamespace); apiErr != nil {
+		t.Fatalf("Expected federated graph to be returned but got error: %v", apiErr)
+	}
+
+	if calls := server.Calls("GetSubgraphs") + server.Calls("GetFederatedGraphs"); calls != 2 {
+		t.Errorf("Expected the namespace to be loaded once through the list endpoints, got: %d", calls)
+	}
+	if calls := server.Calls("GetSubgraphByName") + server.Calls("GetSubgraphById"); calls != 0 {
+		t.Errorf("Expected the subgraphs to be served from the cache, got: %d", calls)
+	}
+	if calls := server.Calls("GetFederatedGraphById") + server.Calls("GetFederatedGraphByName"); calls != 0 {
+		t.Errorf("Expected the federated graph to be served from the cache, got: %d", calls)
+	}
+}
+
+func TestReadCacheLoadsNamespaceOfLookupById(t *testing.T) {
+	server := newFakeServer(3)
+	client, err := api.NewClient("api_key", server.Start(t))
+	if err != nil {
+		t.Fatalf("Expected client to be created but got error: %v", err)
+	}
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		subgraph, apiErr := client.GetSubgraphById(ctx, fmt.Sprintf("subgraph-%d-id", i))
+		if apiErr != nil {
+			t.Fatalf("Expected subgraph to be returned but got error: %v", apiErr)
+		}
+		if subgraph.GetName() != fmt.Sprintf("subgraph-%d", i) {
+			t.Errorf("Expected subgraph-%d, got: %s", i, subgraph.GetName())
+		}
+	}
+
+	if calls := server.Calls("GetSubgraphById"); calls != 1 {
+		t.Errorf("Expected only the first subgraph to be fetched by ID, got: %d", calls)
+	}
+	if calls := server.Calls("GetSubgraphs"); calls != 1 {
+		t.Errorf("Expected its namespace to be loaded once, got: %d", calls)
+	}
+}
+
+func TestReadCacheLoadsNamespaceOnceConcurrently(t *testing.T) {
+	server := newFakeServer(10)
+	client, err := api.NewClient("api_key", server.Start(t))
+	if err != nil {
+		t.Fatalf("Expected client to be created but got error: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if _, apiErr := client.GetSubgraph(context.Background(), fmt.Sprintf("subgraph-%d", i), fakeNamespace); apiErr != nil {
+				t.Errorf("Expected subgraph to be returned but got error: %v", apiErr)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if calls := server.Calls("GetSubgraphs"); calls != 1 {
+		t.Errorf("Expected concurrent reads to share one load, got: %d", calls)
+	}
+	if calls := server.Calls("GetSubgraphByName"); calls != 0 {
+		t.Errorf("Expected the subgraphs to be served from the cache, got: %d", calls)
+	}
+}
+
+func TestReadCacheRetriesFailedLoad(t *testing.T) {
+	server := newFakeServer(1)
+	server.Fail("GetSubgraphs", connect.CodeInternal)
+	client, err := api.NewClient("api_key", server.Start(t))
+	if err != nil {
+		t.Fatalf("Expected client to be created but got error: %v", err)
+	}
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if _, apiErr := client.GetSubgraph(ctx, "subgraph-0", fakeNamespace); apiErr != nil {
+			t.Fatalf("Expected subgraph to be returned but got error: %v", apiErr)
+		}
+	}
+
+	if calls := server.Calls("GetSubgraphByName"); calls != 1 {
+		t.Errorf("Expected the read to fall back to the direct lookup once, got: %d", calls)
+	}
+	if calls := server.Calls("GetSubgraphs"); calls != 2 {
+		t.Errorf("Expected the failed load to be retried, got: %d", calls)
+	}
+}
+
+func TestReadCacheReturnsCopies(t *testing.T) {
+	server := newFakeServer(1)
+	client, err := api.NewClient("api_key", server.Start(t))
+	if err != nil {
+		t.Fatalf("Expected client to be created but got error: %v", err)
+	}
+
+	ctx := context.Background()
+	subgraph, apiErr := client.GetSubgraph(ctx, "subgraph-0", fakeNamespace)
+	if apiErr != nil {
+		t.Fatalf("Expected subgraph to be returned but got error: %v", apiErr)
+	}
+	subgraph.RoutingURL = "http://modified"
+
+	graph, apiErr := client.GetFederatedGraph(ctx, "graph", fakeNamespace)
+	if apiErr != nil {
+		t.Fatalf("Expected federated graph to be returned but got error: %v", apiErr)
+	}
+	graph.Graph.RoutingURL = "http://modified"
+
+	subgraph, apiErr = client.GetSubgraphById(ctx, "subgraph-0-id")
+	if apiErr != nil {
+		t.Fatalf("Expected subgraph to be returned but got error: %v", apiErr)
+	}
+	if subgraph.GetRoutingURL() == "http://modified" {
+		t.Errorf("Expected the cached subgraph to be unchanged")
+	}
+
+	graph, apiErr = client.GetFederatedGraph(ctx, "graph", fakeNamespace)
+	if apiErr != nil {
+		t.Fatalf("Expected federated graph to be returned but got error: %v", apiErr)
+	}
+	if graph.GetGraph().GetRoutingURL() == "http://modified" {
+		t.Errorf("Expected the cached federated graph to be unchanged")
+	}
+}
+
+func TestReadCacheFallsBackForUnknownSubgraph(t *testing.T) {
+	server := newFakeServer(1)
+	client, err := api.NewClient("api_key", server.Start(t))
+	if err != nil {
+		t.Fatalf("Expected client to be created but got error: %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		_, apiErr := client.GetSubgraph(context.Background(), "unknown", fakeNamespace)
+		if apiErr == nil || !api.IsNotFoundError(apiErr) {
+			t.Fatalf("Expected not found error, got: %v", apiErr)
+		}
+	}
+
+	if calls := server.Calls("GetSubgraphByName"); calls != 2 {
+		t.Errorf("Expected every lookup of an unknown subgraph to be sent, got: %d", calls)
+	}
+}
+
+func TestReadCacheInvalidatedOnMutation(t *testing.T) {
+	server := newFakeServer(1)
+	client, err := api.NewClient("api_key", server.Start(t))
+	if err != nil {
+		t.Fatalf("Expected client to be created but got error: %v", err)
+	}
+
+	ctx := context.Background()
+	if _, apiErr := client.GetSubgraphSchema(ctx, "subgraph-0", fakeNamespace); apiErr != nil {
+		t.Fatalf("Expected schema to be returned but got error: %v", apiErr)
+	}
+
+	updatedSchema := "type Query { updated: String }"
+	if _, apiErr := client.PublishSubgraph(ctx, "subgraph-0", fakeNamespace, updatedSchema); apiErr != nil {
+		t.Fatalf("Expected subgraph to be published but got error: %v", apiErr)
+	}
+
+	schema, apiErr := client.GetSubgraphSchema(ctx, "subgraph-0", fakeNamespace)
+	if apiErr != nil {
+		t.Fatalf("Expected schema to be returned but got error: %v", apiErr)
+	}
+	if schema != updatedSchema {
+		t.Errorf("Expected the published schema, got: %s", schema)
+	}
+
+	if calls := server.Calls("GetLatestSubgraphSDL"); calls != 2 {
+		t.Errorf("Expected the schema to be fetched again after the mutation, got: %d", calls)
+	}
+}
+
+func TestReadCacheKeptOnUnrelatedMutations(t *testing.T) {
+	server := newFakeServer(1)
+	server.AddSubgraph(&platformv1.Subgraph{Id: "other-id", Name: "other", Namespace: "other"}, "type Query { other: String }")
+	server.SetUser("admin@example.com")
+	server.AddOrganizationMember(&platformv1.OrgMember{Email: "member@example.com"})
+
+	client, err := api.NewClient("api_key", server.Start(t))
+	if err != nil {
+		t.Fatalf("Expected client to be created but got error: %v", err)
+	}
+
+	ctx := context.Background()
+	if _, apiErr := client.GetSubgraph(ctx, "subgraph-0", fakeNamespace); apiErr != nil {
+		t.Fatalf("Expected subgraph to be returned but got error: %v", apiErr)
+	}
+
+	// A publish in another namespace and a mutation which doesn't change
+	// graphs at all leave the cached subgraph in place.
+	if _, apiErr := client.PublishSubgraph(ctx, "other", "other", "type Query { updated: String }"); apiErr != nil {
+		t.Fatalf("Expected subgraph to be published but got error: %v", apiErr)
+	}
+	if apiErr := client.RemoveOrganizationMember(ctx, "member@example.com"); apiErr != nil {
+		t.Fatalf("Expected member to be removed but got error: %v", apiErr)
+	}
+
+	if _, apiErr := client.GetSubgraph(ctx, "subgraph-0", fakeNamespace); apiErr != nil {
+		t.Fatalf("Expected subgraph to be returned but got error: %v", apiErr)
+	}
+
+	if calls := server.Calls("GetSubgraphs"); calls != 1 {
+		t.Errorf("Expected the namespace to be loaded once, got: %d", calls)
+	}
+	if calls := server.Calls("GetSubgraphByName"); calls != 0 {
+		t.Errorf("Expected the subgraph to be served from the cache, got: %d", calls)
+	}
+}
+
+func TestReadCacheDisabled(t *testing.T) {
+	server := newFakeServer(1)
+	client, err := api.NewClient("api_key", server.Start(t), api.WithReadCache(false))
+	if err != nil {
+		t.Fatalf("Expected client to be created but got error: %v", err)
+	}
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if _, apiErr := client.GetSubgraph(ctx, "subgraph-0", fakeNamespace); apiErr != nil {
+			t.Fatalf("Expected subgraph to be returned but got error: %v", apiErr)
+		}
+	}
+
+	if calls := server.Calls("GetSubgraphByName"); calls != 2 {
+		t.Errorf("Expected every read to be sent, got: %d", calls)
+	}
+}
+
+// benchmarkRefresh reads every subgraph and its schema once with a new
+// client, as the refresh of a configuration with a resource per subgraph
+// would, and reports the RPCs sent per refresh.
+func benchmarkRefresh(b *testing.B, opts ...api.ClientOption) {
+	const subgraphs = 300
+
+	server := newFakeServer(subgraphs)
+	url := server.Start(b)
+	ctx := context.Background()
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		client, err := api.NewClient("api_key", url, opts...)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		for i := 0; i < subgraphs; i++ {
+			name := fmt.Sprintf("subgraph-%d", i)
+			if _, apiErr := client.GetSubgraph(ctx, name, fakeNamespace); apiErr != nil {
+				b.Fatal(apiErr)
+			}
+			if _, apiErr := client.GetSubgraphSchema(ctx, name, fakeNamespace); apiErr != nil {
+				b.Fatal(apiErr)
+			}
+		}
+	}
+	b.StopTimer()
+
+	calls := 0
+	for _, procedure := range []string{"GetFederatedGraphs", "GetSubgraphs", "GetSubgraphByName", "GetLatestSubgraphSDL"} {
+		calls += server.Calls(procedure)
+	}
+	b.ReportMetric(float64(calls)/float64(b.N), "calls/op")
+}
+
+func BenchmarkRefreshWithReadCache(b *testing.B) {
+	benchmarkRefresh(b)
+}
+
+func BenchmarkRefreshWithoutReadCache(b *testing.B) {
+	benchmarkRefresh(b, api.WithReadCache(false))
+}
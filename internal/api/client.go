@@ -4,17 +4,87 @@ import (
 	"errors"
 	"net/http"
 	"os"
+	"sync"
+	"time"
 
 	"github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1/platformv1connect"
 	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
 )
 
 type PlatformClient struct {
-	Client      platformv1connect.PlatformServiceClient
-	cosmoApiKey string
+	Client       platformv1connect.PlatformServiceClient
+	cosmoApiKey  string
+	cache        *readCache
+	compositions *compositionLocks
+	options      clientOptions
+	// scriptCreations holds a *sync.Mutex per playground script type.
+	scriptCreations *sync.Map
 }
 
-func NewClient(apiKey, apiUrl string) (*PlatformClient, error) {
+type clientOptions struct {
+	readCache             bool
+	serializeCompositions bool
+	timeout               time.Duration
+	retries               int
+	retryBackoff          time.Duration
+	interceptors          []Interceptor
+}
+
+// ClientOption configures optional behaviour of the PlatformClient.
+type ClientOption func(*clientOptions)
+
+// WithReadCache enables or disables the in-memory read cache. The cache is
+// enabled by default.
+func WithReadCache(enabled bool) ClientOption {
+	return func(o *clientOptions) {
+		o.readCache = enabled
+	}
+}
+
+// WithCompositionLock enables or disables serializing the mutations which
+// trigger a composition, e.g. publishing a subgraph, per namespace. The lock is
+// enabled by default.
+func WithCompositionLock(enabled bool) ClientOption {
+	return func(o *clientOptions) {
+		o.serializeCompositions = enabled
+	}
+}
+
+// WithTimeout limits the duration of a single call to the platform. A zero
+// timeout, the default, leaves calls bounded by their context only.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(o *clientOptions) {
+		o.timeout = timeout
+	}
+}
+
+// WithRetries retries reads which failed because the platform was unavailable
+// or timed out. The wait before each retry grows linearly by backoff. Writes
+// are never retried.
+func WithRetries(retries int, backoff time.Duration) ClientOption {
+	return func(o *clientOptions) {
+		o.retries = retries
+		o.retryBackoff = backoff
+	}
+}
+
+// WithInterceptor adds an interceptor around every call. Interceptors run in
+// the order they were added, the first one being the outermost.
+func WithInterceptor(interceptor Interceptor) ClientOption {
+	return func(o *clientOptions) {
+		o.interceptors = append(o.interceptors, interceptor)
+	}
+}
+
+func NewClient(apiKey, apiUrl string, opts ...ClientOption) (*PlatformClient, error) {
+	options := clientOptions{
+		readCache:             true,
+		serializeCompositions: true,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	cosmoApiKey := apiKey
 	cosmoApiUrl := apiUrl
 
@@ -53,10 +123,22 @@ func NewClient(apiKey, apiUrl string) (*PlatformClient, error) {
 
 	client := platformv1connect.NewPlatformServiceClient(httpClient, cosmoApiUrl)
 
-	return &PlatformClient{
-		Client:      client,
-		cosmoApiKey: cosmoApiKey,
-	}, nil
+	platformClient := &PlatformClient{
+		Client:          client,
+		cosmoApiKey:     cosmoApiKey,
+		options:         options,
+		scriptCreations: &sync.Map{},
+	}
+
+	if options.readCache {
+		platformClient.cache = newReadCache()
+	}
+
+	if options.serializeCompositions {
+		platformClient.compositions = newCompositionLocks()
+	}
+
+	return platformClient, nil
 }
 
 type transportWithAuth struct {
@@ -0,0 +1,49 @@
+package api
+
+import (
+	"context"
+	"sync"
+
+	"github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/common"
+)
+
+// compositionLocks serializes the mutations which trigger a composition per
+// namespace. The control plane composes with whatever state it sees when a
+// mutation arrives, so concurrent publishes to the same namespace can compose
+// against each other's half-applied changes and fail spuriously. Reads and
+// mutations in different namespaces are not affected.
+//
+// A nil *compositionLocks is valid and does not lock at all.
+type compositionLocks struct {
+	mu         sync.Mutex
+	namespaces map[string]chan struct{}
+}
+
+func newCompositionLocks() *compositionLocks {
+	return &compositionLocks{
+		namespaces: map[string]chan struct{}{},
+	}
+}
+
+// lock blocks until no other composing mutation runs in the namespace or the
+// context is done. The returned function releases the lock.
+func (l *compositionLocks) lock(ctx context.Context, namespace string) (func(), *ApiError) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	l.mu.Lock()
+	sem, ok := l.namespaces[namespace]
+	if !ok {
+		sem = make(chan struct{}, 1)
+		l.namespaces[namespace] = sem
+	}
+	l.mu.Unlock()
+
+	select {
+	case sem <- struct{}{}:
+		return func() { <-sem }, nil
+	case <-ctx.Done():
+		return nil, &ApiError{Err: ctx.Err(), Reason: "waiting for a running composition in namespace " + namespace, Status: common.EnumStatusCode_ERR}
+	}
+}
@@ -0,0 +1,156 @@
+package api_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+)
+
+// concurrency is an interceptor which records the highest number of calls in
+// flight at the same time.
+type concurrency struct {
+	mu      sync.Mutex
+	current int
+	max     int
+}
+
+func (c *concurrency) intercept(ctx context.Context, method string, next func(context.Context) *api.ApiError) *api.ApiError {
+	c.mu.Lock()
+	c.current++
+	if c.current > c.max {
+		c.max = c.current
+	}
+	c.mu.Unlock()
+
+	time.Sleep(5 * time.Millisecond)
+	defer func() {
+		c.mu.Lock()
+		c.current--
+		c.mu.Unlock()
+	}()
+
+	return next(ctx)
+}
+
+func publishConcurrently(t *testing.T, client *api.PlatformClient, namespaces ...string) {
+	var wg sync.WaitGroup
+	for _, namespace := range namespaces {
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func(namespace string, i int) {
+				defer wg.Done()
+				schema := fmt.Sprintf("type Query { field%d: String }", i)
+				if _, apiErr := client.PublishSubgraph(context.Background(), "subgraph-0", namespace, schema); apiErr != nil {
+					t.Errorf("Expected subgraph to be published but got error: %v", apiErr)
+				}
+			}(namespace, i)
+		}
+	}
+	wg.Wait()
+}
+
+func TestCompositionLockSerializesNamespace(t *testing.T) {
+	server := newFakeServer(1)
+	calls := &concurrency{}
+	client, err := api.NewClient("api_key", server.Start(t), api.WithInterceptor(calls.intercept))
+	if err != nil {
+		t.Fatalf("Expected client to be created but got error: %v", err)
+	}
+
+	publishConcurrently(t, client, fakeNamespace)
+
+	if calls.max != 1 {
+		t.Errorf("Expected publishes to run one at a time, got: %d", calls.max)
+	}
+}
+
+func TestCompositionLockSerializesMonographs(t *testing.T) {
+	server := newFakeServer(1)
+	calls := &concurrency{}
+	client, err := api.NewClient("api_key", server.Start(t), api.WithInterceptor(calls.intercept))
+	if err != nil {
+		t.Fatalf("Expected client to be created but got error: %v", err)
+	}
+
+	// Monograph publishes take the same lock as the subgraph publishes of
+	// the namespace.
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			schema := fmt.Sprintf("type Query { field%d: String }", i)
+			if apiErr := client.PublishMonograph(context.Background(), "graph", fakeNamespace, schema); apiErr != nil {
+				t.Errorf("Expected monograph to be published but got error: %v", apiErr)
+			}
+		}(i)
+	}
+	publishConcurrently(t, client, fakeNamespace)
+	wg.Wait()
+
+	if calls.max != 1 {
+		t.Errorf("Expected publishes to run one at a time, got: %d", calls.max)
+	}
+	if calls := server.Calls("PublishMonograph"); calls != 5 {
+		t.Errorf("Expected every monograph publish to be sent, got: %d", calls)
+	}
+}
+
+func TestCompositionLockPerNamespace(t *testing.T) {
+	server := newFakeServer(1)
+	server.AddSubgraph(&platformv1.Subgraph{Id: "other-id", Name: "subgraph-0", Namespace: "other"}, "type Query { a: String }")
+
+	calls := &concurrency{}
+	client, err := api.NewClient("api_key", server.Start(t), api.WithInterceptor(calls.intercept))
+	if err != nil {
+		t.Fatalf("Expected client to be created but got error: %v", err)
+	}
+
+	publishConcurrently(t, client, fakeNamespace, "other")
+
+	if calls.max > 2 {
+		t.Errorf("Expected at most one publish per namespace at a time, got: %d", calls.max)
+	}
+}
+
+func TestCompositionLockCanceled(t *testing.T) {
+	server := newFakeServer(1)
+	release := make(chan struct{})
+	blocked := make(chan struct{})
+	client, err := api.NewClient("api_key", server.Start(t), api.WithInterceptor(
+		func(ctx context.Context, method string, next func(context.Context) *api.ApiError) *api.ApiError {
+			close(blocked)
+			<-release
+			return next(ctx)
+		},
+	))
+	if err != nil {
+		t.Fatalf("Expected client to be created but got error: %v", err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = client.PublishSubgraph(context.Background(), "subgraph-0", fakeNamespace, "type Query { a: String }")
+	}()
+	<-blocked
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, apiErr := client.PublishSubgraph(ctx, "subgraph-0", fakeNamespace, "type Query { b: String }"); apiErr == nil {
+		t.Error("Expected the canceled publish to fail while waiting for the lock")
+	}
+
+	close(release)
+	<-done
+
+	if calls := server.Calls("PublishFederatedSubgraph"); calls != 1 {
+		t.Errorf("Expected only the first publish to be sent, got: %d", calls)
+	}
+}
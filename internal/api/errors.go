@@ -18,6 +18,9 @@ var (
 	ErrEmptyMsg                  = errors.New("ErrEmptyMsg")
 	ErrContractCompositionFailed = errors.New("ErrContractCompositionFailed")
 	ErrInvalidSubgraphSchema     = errors.New("ErrInvalidSubgraphSchema")
+	ErrRemovingOwnUser           = errors.New("ErrRemovingOwnUser")
+	ErrUnknownOwnUser            = errors.New("ErrUnknownOwnUser")
+	ErrAmbiguousPlaygroundScript = errors.New("ErrAmbiguousPlaygroundScript")
 )
 
 const (
@@ -40,6 +43,14 @@ func IsContractCompositionFailedError(err *ApiError) bool {
 	return errors.Is(err.Err, ErrContractCompositionFailed)
 }
 
+func IsRemovingOwnUserError(err *ApiError) bool {
+	return errors.Is(err.Err, ErrRemovingOwnUser)
+}
+
+func IsUnknownOwnUserError(err *ApiError) bool {
+	return errors.Is(err.Err, ErrUnknownOwnUser)
+}
+
 type ApiError struct {
 	Err    error
 	Reason string
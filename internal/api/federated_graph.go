@@ -3,12 +3,17 @@ package api
 import (
 	"context"
 
-	"connectrpc.com/connect"
-	"github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/common"
 	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
 )
 
 func (p *PlatformClient) CreateFederatedGraph(ctx context.Context, admissionWebhookSecret *string, graph *platformv1.FederatedGraph) (*platformv1.CreateFederatedGraphResponse, *ApiError) {
+	unlock, lockErr := p.compositions.lock(ctx, graph.Namespace)
+	if lockErr != nil {
+		return nil, lockErr
+	}
+	defer unlock()
+	defer p.cache.invalidate(graph.Namespace)
+
 	var admissionWebhookURL string
 	if graph.AdmissionWebhookUrl != nil {
 		admissionWebhookURL = *graph.AdmissionWebhookUrl
@@ -16,7 +21,7 @@ func (p *PlatformClient) CreateFederatedGraph(ctx context.Context, admissionWebh
 		admissionWebhookURL = ""
 	}
 
-	request := connect.NewRequest(&platformv1.CreateFederatedGraphRequest{
+	return invoke(ctx, p, "CreateFederatedGraph", write, p.Client.CreateFederatedGraph, &platformv1.CreateFederatedGraphRequest{
 		Name:                   graph.Name,
 		Namespace:              graph.Namespace,
 		RoutingUrl:             graph.RoutingURL,
@@ -25,31 +30,22 @@ func (p *PlatformClient) CreateFederatedGraph(ctx context.Context, admissionWebh
 		Readme:                 graph.Readme,
 		LabelMatchers:          graph.LabelMatchers,
 	})
-
-	response, err := p.Client.CreateFederatedGraph(ctx, request)
-	if err != nil {
-		return nil, &ApiError{Err: err, Reason: "CreateFederatedGraph", Status: common.EnumStatusCode_ERR}
-	}
-
-	if response.Msg == nil {
-		return nil, &ApiError{Err: ErrEmptyMsg, Reason: "CreateFederatedGraph", Status: common.EnumStatusCode_ERR}
-	}
-
-	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
-	if apiError != nil {
-		return nil, apiError
-	}
-
-	return response.Msg, nil
 }
 
 func (p *PlatformClient) UpdateFederatedGraph(ctx context.Context, admissionWebhookSecret *string, graph *platformv1.FederatedGraph) (*platformv1.UpdateFederatedGraphResponse, *ApiError) {
+	unlock, lockErr := p.compositions.lock(ctx, graph.Namespace)
+	if lockErr != nil {
+		return nil, lockErr
+	}
+	defer unlock()
+	defer p.cache.invalidate(graph.Namespace)
+
 	var admissionWebhookURL *string
 	if graph.AdmissionWebhookUrl != nil {
 		admissionWebhookURL = graph.AdmissionWebhookUrl
 	}
 
-	request := connect.NewRequest(&platformv1.UpdateFederatedGraphRequest{
+	return invoke(ctx, p, "UpdateFederatedGraph", write, p.Client.UpdateFederatedGraph, &platformv1.UpdateFederatedGraphRequest{
 		Name:                   graph.Name,
 		Namespace:              graph.Namespace,
 		RoutingUrl:             graph.RoutingURL,
@@ -58,88 +54,71 @@ func (p *PlatformClient) UpdateFederatedGraph(ctx context.Context, admissionWebh
 		LabelMatchers:          graph.LabelMatchers,
 		Readme:                 graph.Readme,
 	})
-
-	response, err := p.Client.UpdateFederatedGraph(ctx, request)
-	if err != nil {
-		return nil, &ApiError{Err: err, Reason: "UpdateFederatedGraph", Status: common.EnumStatusCode_ERR}
-	}
-
-	if response.Msg == nil {
-		return nil, &ApiError{Err: ErrEmptyMsg, Reason: "UpdateFederatedGraph", Status: common.EnumStatusCode_ERR}
-	}
-
-	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
-	if apiError != nil {
-		return nil, apiError
-	}
-
-	return response.Msg, nil
 }
 
 func (p *PlatformClient) DeleteFederatedGraph(ctx context.Context, name, namespace string) *ApiError {
-	request := connect.NewRequest(&platformv1.DeleteFederatedGraphRequest{
+	unlock, lockErr := p.compositions.lock(ctx, namespace)
+	if lockErr != nil {
+		return lockErr
+	}
+	defer unlock()
+	defer p.cache.invalidate(namespace)
+
+	_, apiError := invoke(ctx, p, "DeleteFederatedGraph", write, p.Client.DeleteFederatedGraph, &platformv1.DeleteFederatedGraphRequest{
 		Name:      name,
 		Namespace: namespace,
 	})
+	return apiError
+}
 
-	response, err := p.Client.DeleteFederatedGraph(ctx, request)
-	if err != nil {
-		return &ApiError{Err: err, Reason: "DeleteFederatedGraph", Status: common.EnumStatusCode_ERR}
-	}
+func (p *PlatformClient) GetFederatedGraph(ctx context.Context, name, namespace string) (*platformv1.GetFederatedGraphByNameResponse, *ApiError) {
+	return p.getFederatedGraph(ctx, "GetFederatedGraph", name, namespace)
+}
 
-	if response.Msg == nil {
-		return &ApiError{Err: ErrEmptyMsg, Reason: "DeleteFederatedGraph", Status: common.EnumStatusCode_ERR}
-	}
+func (p *PlatformClient) GetFederatedGraphById(ctx context.Context, id string) (*platformv1.GetFederatedGraphByIdResponse, *ApiError) {
+	return p.getFederatedGraphById(ctx, "GetFederatedGraphById", id)
+}
 
-	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
-	if apiError != nil {
-		return apiError
+// getFederatedGraph and getFederatedGraphById are shared by federated graphs,
+// contracts and monographs, which are all read through the same endpoints.
+// Graphs served from the read cache come from the list endpoint, their
+// response carries the graph only.
+func (p *PlatformClient) getFederatedGraph(ctx context.Context, method, name, namespace string) (*platformv1.GetFederatedGraphByNameResponse, *ApiError) {
+	if graph, ok := p.namespace(ctx, namespace).federatedGraph(name); ok {
+		return &platformv1.GetFederatedGraphByNameResponse{Graph: graph}, nil
 	}
 
-	return nil
+	return p.fetchFederatedGraph(ctx, method, name, namespace)
 }
 
-func (p *PlatformClient) GetFederatedGraph(ctx context.Context, name, namespace string) (*platformv1.GetFederatedGraphByNameResponse, *ApiError) {
-	request := connect.NewRequest(&platformv1.GetFederatedGraphByNameRequest{
-		Name:      name,
-		Namespace: namespace,
-	})
-
-	response, err := p.Client.GetFederatedGraphByName(ctx, request)
-	if err != nil {
-		return nil, &ApiError{Err: err, Reason: "GetFederatedGraph", Status: common.EnumStatusCode_ERR}
-	}
-
-	if response.Msg == nil {
-		return nil, &ApiError{Err: ErrEmptyMsg, Reason: "GetFederatedGraph", Status: common.EnumStatusCode_ERR}
+func (p *PlatformClient) getFederatedGraphById(ctx context.Context, method, id string) (*platformv1.GetFederatedGraphByIdResponse, *ApiError) {
+	if graph, ok := p.cache.federatedGraphById(id); ok {
+		return &platformv1.GetFederatedGraphByIdResponse{Graph: graph}, nil
 	}
 
-	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
+	response, apiError := p.fetchFederatedGraphById(ctx, method, id)
 	if apiError != nil {
 		return nil, apiError
 	}
 
-	return response.Msg, nil
+	// Load the namespace of the graph, so that the other graphs and subgraphs
+	// of the namespace are served from the cache.
+	p.namespace(ctx, response.GetGraph().GetNamespace())
+
+	return response, nil
 }
 
-func (p *PlatformClient) GetFederatedGraphById(ctx context.Context, id string) (*platformv1.GetFederatedGraphByIdResponse, *ApiError) {
-	request := connect.NewRequest(&platformv1.GetFederatedGraphByIdRequest{
-		Id: id,
+// fetchFederatedGraph and fetchFederatedGraphById bypass the read cache, for
+// callers which need the subgraphs of the graph.
+func (p *PlatformClient) fetchFederatedGraph(ctx context.Context, method, name, namespace string) (*platformv1.GetFederatedGraphByNameResponse, *ApiError) {
+	return invoke(ctx, p, method, read, p.Client.GetFederatedGraphByName, &platformv1.GetFederatedGraphByNameRequest{
+		Name:      name,
+		Namespace: namespace,
 	})
+}
 
-	response, err := p.Client.GetFederatedGraphById(ctx, request)
-	if err != nil {
-		return nil, &ApiError{Err: err, Reason: "GetFederatedGraph", Status: common.EnumStatusCode_ERR}
-	}
-
-	if response.Msg == nil {
-		return nil, &ApiError{Err: ErrEmptyMsg, Reason: "GetFederatedGraph", Status: common.EnumStatusCode_ERR}
-	}
-
-	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
-	if apiError != nil {
-		return nil, apiError
-	}
-
-	return response.Msg, nil
+func (p *PlatformClient) fetchFederatedGraphById(ctx context.Context, method, id string) (*platformv1.GetFederatedGraphByIdResponse, *ApiError) {
+	return invoke(ctx, p, method, read, p.Client.GetFederatedGraphById, &platformv1.GetFederatedGraphByIdRequest{
+		Id: id,
+	})
 }
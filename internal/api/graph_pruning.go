@@ -0,0 +1,41 @@
+package api
+
+import (
+	"context"
+
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+)
+
+const (
+	GraphPruningRuleUnusedFields                     = "UNUSED_FIELDS"
+	GraphPruningRuleDeprecatedFields                 = "DEPRECATED_FIELDS"
+	GraphPruningRuleRequireDeprecationBeforeDeletion = "REQUIRE_DEPRECATION_BEFORE_DELETION"
+)
+
+// GraphPruningPeriodsInDays are the grace and schema usage check periods the
+// platform offers for graph pruning rules.
+var GraphPruningPeriodsInDays = []int64{7, 14, 30, 60, 90}
+
+func (p *PlatformClient) EnableNamespaceGraphPruning(ctx context.Context, namespace string, enabled bool) *ApiError {
+	_, apiError := invoke(ctx, p, "EnableNamespaceGraphPruning", write, p.Client.EnableGraphPruning, &platformv1.EnableGraphPruningRequest{
+		Namespace:          namespace,
+		EnableGraphPruning: enabled,
+	})
+	return apiError
+}
+
+// ConfigureNamespaceGraphPruningRules replaces all graph pruning rules of the
+// namespace.
+func (p *PlatformClient) ConfigureNamespaceGraphPruningRules(ctx context.Context, namespace string, configs []*platformv1.GraphPruningConfig) *ApiError {
+	_, apiError := invoke(ctx, p, "ConfigureNamespaceGraphPruningRules", write, p.Client.ConfigureNamespaceGraphPruningConfig, &platformv1.ConfigureNamespaceGraphPruningConfigRequest{
+		Namespace: namespace,
+		Configs:   configs,
+	})
+	return apiError
+}
+
+func (p *PlatformClient) GetNamespaceGraphPruningConfig(ctx context.Context, namespace string) (*platformv1.GetNamespaceGraphPruningConfigResponse, *ApiError) {
+	return invoke(ctx, p, "GetNamespaceGraphPruningConfig", read, p.Client.GetNamespaceGraphPruningConfig, &platformv1.GetNamespaceGraphPruningConfigRequest{
+		Namespace: namespace,
+	})
+}
@@ -0,0 +1,129 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/common"
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+)
+
+// Interceptor wraps every call of the PlatformClient, e.g. to add logging or
+// tracing. Method is the name of the PlatformClient method, e.g. "GetSubgraph".
+type Interceptor func(ctx context.Context, method string, next func(context.Context) *ApiError) *ApiError
+
+// callKind distinguishes reads, which are safe to retry, from writes, which
+// are never retried. Writes which change graphs invalidate the read cache of
+// their namespace themselves.
+type callKind int
+
+const (
+	read callKind = iota
+	write
+)
+
+// platformResponse is implemented by every response message of the platform
+// service.
+type platformResponse[T any] interface {
+	*T
+	GetResponse() *platformv1.Response
+	String() string
+}
+
+// invoke sends the request through the given RPC and turns transport errors,
+// empty messages and non OK status codes into an *ApiError reporting method
+// as its reason.
+func invoke[Req, Resp any, PResp platformResponse[Resp]](
+	ctx context.Context,
+	p *PlatformClient,
+	method string,
+	kind callKind,
+	rpc func(context.Context, *connect.Request[Req]) (*connect.Response[Resp], error),
+	req *Req,
+) (PResp, *ApiError) {
+	var msg PResp
+	call := func(ctx context.Context) *ApiError {
+		for attempt := 0; ; attempt++ {
+			response, err := send(ctx, p.options.timeout, rpc, req)
+			if err != nil {
+				if kind == read && attempt < p.options.retries && isTransient(ctx, err) {
+					if !sleep(ctx, p.options.retryBackoff*time.Duration(attempt+1)) {
+						return &ApiError{Err: ctx.Err(), Reason: method, Status: common.EnumStatusCode_ERR}
+					}
+					continue
+				}
+				return &ApiError{Err: err, Reason: method, Status: common.EnumStatusCode_ERR}
+			}
+
+			if response.Msg == nil {
+				return &ApiError{Err: ErrEmptyMsg, Reason: method, Status: common.EnumStatusCode_ERR}
+			}
+
+			msg = response.Msg
+			return handleErrorCodes(msg.GetResponse().GetCode(), msg.String())
+		}
+	}
+
+	for i := len(p.options.interceptors) - 1; i >= 0; i-- {
+		interceptor, next := p.options.interceptors[i], call
+		call = func(ctx context.Context) *ApiError {
+			return interceptor(ctx, method, next)
+		}
+	}
+
+	if apiErr := call(ctx); apiErr != nil {
+		return nil, apiErr
+	}
+
+	return msg, nil
+}
+
+func send[Req, Resp any](
+	ctx context.Context,
+	timeout time.Duration,
+	rpc func(context.Context, *connect.Request[Req]) (*connect.Response[Resp], error),
+	req *Req,
+) (*connect.Response[Resp], error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	request := connect.NewRequest(req)
+	for key, values := range outgoingHeader(ctx) {
+		request.Header()[key] = values
+	}
+
+	return rpc(ctx, request)
+}
+
+// isTransient reports whether the error is worth a retry: the control plane
+// was unavailable or a single attempt ran into the configured timeout while
+// the caller's context is still alive.
+func isTransient(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	switch connect.CodeOf(err) {
+	case connect.CodeUnavailable, connect.CodeDeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
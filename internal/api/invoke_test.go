@@ -0,0 +1,80 @@
+package api_test
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+)
+
+func TestInterceptorsWrapCalls(t *testing.T) {
+	server := newFakeServer(1)
+
+	var methods []string
+	record := func(name string) api.Interceptor {
+		return func(ctx context.Context, method string, next func(context.Context) *api.ApiError) *api.ApiError {
+			methods = append(methods, name+":"+method)
+			return next(ctx)
+		}
+	}
+
+	client, err := api.NewClient("api_key", server.Start(t),
+		api.WithReadCache(false),
+		api.WithInterceptor(record("outer")),
+		api.WithInterceptor(record("inner")),
+	)
+	if err != nil {
+		t.Fatalf("Expected client to be created but got error: %v", err)
+	}
+
+	if _, apiErr := client.GetSubgraphSchema(context.Background(), "subgraph-0", fakeNamespace); apiErr != nil {
+		t.Fatalf("Expected schema to be returned but got error: %v", apiErr)
+	}
+
+	expected := []string{"outer:GetSubgraphSchema", "inner:GetSubgraphSchema"}
+	if len(methods) != len(expected) || methods[0] != expected[0] || methods[1] != expected[1] {
+		t.Errorf("Expected interceptors %v, got: %v", expected, methods)
+	}
+}
+
+func TestReadsAreRetried(t *testing.T) {
+	server := newFakeServer(1)
+	server.Fail("GetSubgraphByName", connect.CodeUnavailable, connect.CodeUnavailable)
+
+	client, err := api.NewClient("api_key", server.Start(t), api.WithReadCache(false), api.WithRetries(2, 0))
+	if err != nil {
+		t.Fatalf("Expected client to be created but got error: %v", err)
+	}
+
+	if _, apiErr := client.GetSubgraph(context.Background(), "subgraph-0", fakeNamespace); apiErr != nil {
+		t.Fatalf("Expected subgraph to be returned but got error: %v", apiErr)
+	}
+
+	if calls := server.Calls("GetSubgraphByName"); calls != 3 {
+		t.Errorf("Expected the read to be sent three times, got: %d", calls)
+	}
+}
+
+func TestWritesAreNotRetried(t *testing.T) {
+	server := newFakeServer(1)
+	server.Fail("PublishFederatedSubgraph", connect.CodeUnavailable)
+
+	client, err := api.NewClient("api_key", server.Start(t), api.WithRetries(2, 0))
+	if err != nil {
+		t.Fatalf("Expected client to be created but got error: %v", err)
+	}
+
+	_, apiErr := client.PublishSubgraph(context.Background(), "subgraph-0", fakeNamespace, "type Query { a: String }")
+	if apiErr == nil {
+		t.Fatal("Expected publish to fail")
+	}
+	if apiErr.Reason != "PublishSubgraph" {
+		t.Errorf("Expected the method as reason, got: %s", apiErr.Reason)
+	}
+
+	if calls := server.Calls("PublishFederatedSubgraph"); calls != 1 {
+		t.Errorf("Expected the write to be sent once, got: %d", calls)
+	}
+}
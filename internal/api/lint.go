@@ -0,0 +1,52 @@
+package api
+
+import (
+	"context"
+
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+)
+
+const (
+	LintSeverityWarn  = "warn"
+	LintSeverityError = "error"
+)
+
+func ResolveLintSeverity(severity string) platformv1.LintSeverity {
+	switch severity {
+	case LintSeverityError:
+		return platformv1.LintSeverity_error
+	// LintSeverityWarn
+	default:
+		return platformv1.LintSeverity_warn
+	}
+}
+
+func LintSeverityString(severity platformv1.LintSeverity) string {
+	if severity == platformv1.LintSeverity_error {
+		return LintSeverityError
+	}
+	return LintSeverityWarn
+}
+
+func (p *PlatformClient) EnableNamespaceLinting(ctx context.Context, namespace string, enabled bool) *ApiError {
+	_, apiError := invoke(ctx, p, "EnableNamespaceLinting", write, p.Client.EnableLintingForTheNamespace, &platformv1.EnableLintingForTheNamespaceRequest{
+		Namespace:     namespace,
+		EnableLinting: enabled,
+	})
+	return apiError
+}
+
+// ConfigureNamespaceLintRules replaces all lint rules of the namespace.
+func (p *PlatformClient) ConfigureNamespaceLintRules(ctx context.Context, namespace string, configs []*platformv1.LintConfig) *ApiError {
+	_, apiError := invoke(ctx, p, "ConfigureNamespaceLintRules", write, p.Client.ConfigureNamespaceLintConfig, &platformv1.ConfigureNamespaceLintConfigRequest{
+		Namespace: namespace,
+		Configs:   configs,
+	})
+	return apiError
+}
+
+func (p *PlatformClient) GetNamespaceLintConfig(ctx context.Context, namespace string) (*platformv1.GetNamespaceLintConfigResponse, *ApiError) {
+	return invoke(ctx, p, "GetNamespaceLintConfig", read, p.Client.GetNamespaceLintConfig, &platformv1.GetNamespaceLintConfigRequest{
+		Namespace: namespace,
+	})
+}
@@ -3,13 +3,18 @@ package api
 import (
 	"context"
 
-	"connectrpc.com/connect"
-	"github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/common"
 	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
 )
 
 func (p *PlatformClient) CreateMonograph(ctx context.Context, name string, namespace string, routingURL string, graphURL string, subscriptionURL *string, readme *string, websocketSubprotocol string, subscriptionProtocol string, admissionWebhookURL string, admissionWebhookSecret string) (*platformv1.CreateMonographResponse, *ApiError) {
-	request := connect.NewRequest(&platformv1.CreateMonographRequest{
+	unlock, lockErr := p.compositions.lock(ctx, namespace)
+	if lockErr != nil {
+		return nil, lockErr
+	}
+	defer unlock()
+	defer p.cache.invalidate(namespace)
+
+	return invoke(ctx, p, "CreateMonograph", write, p.Client.CreateMonograph, &platformv1.CreateMonographRequest{
 		Name:                   name,
 		Namespace:              namespace,
 		RoutingUrl:             routingURL,
@@ -21,25 +26,17 @@ func (p *PlatformClient) CreateMonograph(ctx context.Context, name string, names
 		AdmissionWebhookURL:    admissionWebhookURL,
 		AdmissionWebhookSecret: &admissionWebhookSecret,
 	})
-	response, err := p.Client.CreateMonograph(ctx, request)
-	if err != nil {
-		return nil, &ApiError{Err: err, Reason: "CreateMonograph", Status: common.EnumStatusCode_ERR}
-	}
-
-	if response.Msg == nil {
-		return nil, &ApiError{Err: ErrEmptyMsg, Reason: "CreateMonograph", Status: common.EnumStatusCode_ERR}
-	}
-
-	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
-	if apiError != nil {
-		return nil, apiError
-	}
-
-	return response.Msg, nil
 }
 
 func (p *PlatformClient) UpdateMonograph(ctx context.Context, name string, namespace string, routingURL string, graphURL string, subscriptionURL *string, readme *string, websocketSubprotocol string, subscriptionProtocol string, admissionWebhookURL string, admissionWebhookSecret string) *ApiError {
-	request := connect.NewRequest(&platformv1.UpdateMonographRequest{
+	unlock, lockErr := p.compositions.lock(ctx, namespace)
+	if lockErr != nil {
+		return lockErr
+	}
+	defer unlock()
+	defer p.cache.invalidate(namespace)
+
+	_, apiError := invoke(ctx, p, "UpdateMonograph", write, p.Client.UpdateMonograph, &platformv1.UpdateMonographRequest{
 		Name:                   name,
 		Namespace:              namespace,
 		RoutingUrl:             routingURL,
@@ -51,108 +48,54 @@ func (p *PlatformClient) UpdateMonograph(ctx context.Context, name string, names
 		AdmissionWebhookURL:    &admissionWebhookURL,
 		AdmissionWebhookSecret: &admissionWebhookSecret,
 	})
-	response, err := p.Client.UpdateMonograph(ctx, request)
-	if err != nil {
-		return &ApiError{Err: err, Reason: "UpdateMonograph", Status: common.EnumStatusCode_ERR}
-	}
-
-	if response.Msg == nil {
-		return &ApiError{Err: ErrEmptyMsg, Reason: "UpdateMonograph", Status: common.EnumStatusCode_ERR}
-	}
-
-	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
-	if apiError != nil {
-		return apiError
-	}
-
-	return nil
+	return apiError
 }
 
 func (p *PlatformClient) DeleteMonograph(ctx context.Context, name string, namespace string) *ApiError {
-	request := connect.NewRequest(&platformv1.DeleteMonographRequest{
-		Name:      name,
-		Namespace: namespace,
-	})
-	response, err := p.Client.DeleteMonograph(ctx, request)
-	if err != nil {
-		return &ApiError{Err: err, Reason: "DeleteMonograph", Status: common.EnumStatusCode_ERR}
-	}
-
-	if response.Msg == nil {
-		return &ApiError{Err: ErrEmptyMsg, Reason: "DeleteMonograph", Status: common.EnumStatusCode_ERR}
-	}
-
-	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
-	if apiError != nil {
-		return apiError
+	unlock, lockErr := p.compositions.lock(ctx, namespace)
+	if lockErr != nil {
+		return lockErr
 	}
+	defer unlock()
+	defer p.cache.invalidate(namespace)
 
-	return nil
-}
-
-func (p *PlatformClient) GetMonograph(ctx context.Context, name string, namespace string) (*platformv1.FederatedGraph, *ApiError) {
-	request := connect.NewRequest(&platformv1.GetFederatedGraphByNameRequest{
+	_, apiError := invoke(ctx, p, "DeleteMonograph", write, p.Client.DeleteMonograph, &platformv1.DeleteMonographRequest{
 		Name:      name,
 		Namespace: namespace,
 	})
-	response, err := p.Client.GetFederatedGraphByName(ctx, request)
-	if err != nil {
-		return nil, &ApiError{Err: err, Reason: "GetMonograph", Status: common.EnumStatusCode_ERR}
-	}
-
-	if response.Msg == nil {
-		return nil, &ApiError{Err: ErrEmptyMsg, Reason: "GetMonograph", Status: common.EnumStatusCode_ERR}
-	}
+	return apiError
+}
 
-	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
+func (p *PlatformClient) GetMonograph(ctx context.Context, name string, namespace string) (*platformv1.FederatedGraph, *ApiError) {
+	response, apiError := p.getFederatedGraph(ctx, "GetMonograph", name, namespace)
 	if apiError != nil {
 		return nil, apiError
 	}
 
-	return response.Msg.Graph, nil
+	return response.Graph, nil
 }
 
 func (p *PlatformClient) GetMonographByID(ctx context.Context, id string) (*platformv1.FederatedGraph, *ApiError) {
-	request := connect.NewRequest(&platformv1.GetFederatedGraphByIdRequest{
-		Id: id,
-	})
-
-	response, err := p.Client.GetFederatedGraphById(ctx, request)
-	if err != nil {
-		return nil, &ApiError{Err: err, Reason: "GetMonographByID", Status: common.EnumStatusCode_ERR}
-	}
-
-	if response.Msg == nil {
-		return nil, &ApiError{Err: ErrEmptyMsg, Reason: "GetMonographByID", Status: common.EnumStatusCode_ERR}
-	}
-
-	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
+	response, apiError := p.getFederatedGraphById(ctx, "GetMonographByID", id)
 	if apiError != nil {
 		return nil, apiError
 	}
 
-	return response.Msg.Graph, nil
+	return response.Graph, nil
 }
 
 func (p *PlatformClient) PublishMonograph(ctx context.Context, name string, namespace string, schema string) *ApiError {
-	request := connect.NewRequest(&platformv1.PublishMonographRequest{
+	unlock, lockErr := p.compositions.lock(ctx, namespace)
+	if lockErr != nil {
+		return lockErr
+	}
+	defer unlock()
+	defer p.cache.invalidate(namespace)
+
+	_, apiError := invoke(ctx, p, "PublishMonograph", write, p.Client.PublishMonograph, &platformv1.PublishMonographRequest{
 		Name:      name,
 		Namespace: namespace,
 		Schema:    schema,
 	})
-	response, err := p.Client.PublishMonograph(ctx, request)
-	if err != nil {
-		return &ApiError{Err: err, Reason: "PublishMonograph", Status: common.EnumStatusCode_ERR}
-	}
-
-	if response.Msg == nil {
-		return &ApiError{Err: ErrEmptyMsg, Reason: "PublishMonograph", Status: common.EnumStatusCode_ERR}
-	}
-
-	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
-	if apiError != nil {
-		return apiError
-	}
-
-	return nil
+	return apiError
 }
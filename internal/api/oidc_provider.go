@@ -0,0 +1,40 @@
+package api
+
+import (
+	"context"
+
+	"github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/common"
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+)
+
+func (p *PlatformClient) CreateOIDCProvider(ctx context.Context, data *platformv1.CreateOIDCProviderRequest) (*platformv1.CreateOIDCProviderResponse, *ApiError) {
+	return invoke(ctx, p, "CreateOIDCProvider", write, p.Client.CreateOIDCProvider, data)
+}
+
+// GetOIDCProvider returns the OIDC provider of the organization, or
+// ErrNotFound if none is connected.
+func (p *PlatformClient) GetOIDCProvider(ctx context.Context) (*platformv1.GetOIDCProviderResponse, *ApiError) {
+	response, apiError := invoke(ctx, p, "GetOIDCProvider", read, p.Client.GetOIDCProvider, &platformv1.GetOIDCProviderRequest{})
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	// Organizations without a provider get an empty response.
+	if response.Name == "" && response.Endpoint == "" {
+		return nil, &ApiError{Err: ErrNotFound, Reason: "GetOIDCProvider", Status: common.EnumStatusCode_ERR_NOT_FOUND}
+	}
+
+	return response, nil
+}
+
+func (p *PlatformClient) UpdateIDPMappers(ctx context.Context, mappers []*platformv1.GroupMapper) *ApiError {
+	_, apiError := invoke(ctx, p, "UpdateIDPMappers", write, p.Client.UpdateIDPMappers, &platformv1.UpdateIDPMappersRequest{
+		Mappers: mappers,
+	})
+	return apiError
+}
+
+func (p *PlatformClient) DeleteOIDCProvider(ctx context.Context) *ApiError {
+	_, apiError := invoke(ctx, p, "DeleteOIDCProvider", write, p.Client.DeleteOIDCProvider, &platformv1.DeleteOIDCProviderRequest{})
+	return apiError
+}
@@ -0,0 +1,55 @@
+package api
+
+import (
+	"context"
+
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+)
+
+func (p *PlatformClient) CreateOperationOverrides(ctx context.Context, graphName, namespace, operationHash, operationName string, changes []*platformv1.OverrideChange) *ApiError {
+	_, apiError := invoke(ctx, p, "CreateOperationOverrides", write, p.Client.CreateOperationOverrides, &platformv1.CreateOperationOverridesRequest{
+		GraphName:     graphName,
+		Namespace:     namespace,
+		OperationHash: operationHash,
+		OperationName: operationName,
+		Changes:       changes,
+	})
+	return apiError
+}
+
+func (p *PlatformClient) RemoveOperationOverrides(ctx context.Context, graphName, namespace, operationHash string, changes []*platformv1.OverrideChange) *ApiError {
+	_, apiError := invoke(ctx, p, "RemoveOperationOverrides", write, p.Client.RemoveOperationOverrides, &platformv1.RemoveOperationOverridesRequest{
+		GraphName:     graphName,
+		Namespace:     namespace,
+		OperationHash: operationHash,
+		Changes:       changes,
+	})
+	return apiError
+}
+
+func (p *PlatformClient) CreateOperationIgnoreAllOverride(ctx context.Context, graphName, namespace, operationHash, operationName string) *ApiError {
+	_, apiError := invoke(ctx, p, "CreateOperationIgnoreAllOverride", write, p.Client.CreateOperationIgnoreAllOverride, &platformv1.CreateOperationIgnoreAllOverrideRequest{
+		GraphName:     graphName,
+		Namespace:     namespace,
+		OperationHash: operationHash,
+		OperationName: operationName,
+	})
+	return apiError
+}
+
+func (p *PlatformClient) RemoveOperationIgnoreAllOverride(ctx context.Context, graphName, namespace, operationHash string) *ApiError {
+	_, apiError := invoke(ctx, p, "RemoveOperationIgnoreAllOverride", write, p.Client.RemoveOperationIgnoreAllOverride, &platformv1.RemoveOperationIgnoreAllOverrideRequest{
+		GraphName:     graphName,
+		Namespace:     namespace,
+		OperationHash: operationHash,
+	})
+	return apiError
+}
+
+func (p *PlatformClient) GetOperationOverrides(ctx context.Context, graphName, namespace, operationHash string) (*platformv1.GetOperationOverridesResponse, *ApiError) {
+	return invoke(ctx, p, "GetOperationOverrides", read, p.Client.GetOperationOverrides, &platformv1.GetOperationOverridesRequest{
+		GraphName:     graphName,
+		Namespace:     namespace,
+		OperationHash: operationHash,
+	})
+}
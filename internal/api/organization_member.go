@@ -0,0 +1,133 @@
+package api
+
+import (
+	"context"
+	"strings"
+
+	"github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/common"
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+)
+
+const (
+	OrganizationRoleAdmin     = "admin"
+	OrganizationRoleDeveloper = "developer"
+	OrganizationRoleViewer    = "viewer"
+)
+
+// memberSearchLimit bounds the members returned when searching by email,
+// which only ever matches a handful of users.
+const memberSearchLimit = 100
+
+func (p *PlatformClient) WhoAmI(ctx context.Context) (*platformv1.WhoAmIResponse, *ApiError) {
+	return invoke(ctx, p, "WhoAmI", read, p.Client.WhoAmI, &platformv1.WhoAmIRequest{})
+}
+
+func (p *PlatformClient) InviteUser(ctx context.Context, email string) *ApiError {
+	_, apiError := invoke(ctx, p, "InviteUser", write, p.Client.InviteUser, &platformv1.InviteUserRequest{
+		Email: email,
+	})
+	return apiError
+}
+
+func (p *PlatformClient) RemoveInvitation(ctx context.Context, email string) *ApiError {
+	_, apiError := invoke(ctx, p, "RemoveInvitation", write, p.Client.RemoveInvitation, &platformv1.RemoveInvitationRequest{
+		Email: email,
+	})
+	return apiError
+}
+
+// GetPendingInvitation returns the invitation of the email which was not yet
+// accepted, or ErrNotFound.
+func (p *PlatformClient) GetPendingInvitation(ctx context.Context, email string) (*platformv1.PendingOrgInvitation, *ApiError) {
+	response, apiError := invoke(ctx, p, "GetPendingInvitation", read, p.Client.GetPendingOrganizationMembers, &platformv1.GetPendingOrganizationMembersRequest{
+		Pagination: &platformv1.Pagination{Limit: memberSearchLimit},
+		Search:     &email,
+	})
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	for _, invitation := range response.PendingInvitations {
+		if strings.EqualFold(invitation.Email, email) {
+			return invitation, nil
+		}
+	}
+
+	return nil, &ApiError{Err: ErrNotFound, Reason: "GetPendingInvitation", Status: common.EnumStatusCode_ERR_NOT_FOUND}
+}
+
+// GetOrganizationMember returns the member of the organization with the
+// email, or ErrNotFound if the user is not a member (yet).
+func (p *PlatformClient) GetOrganizationMember(ctx context.Context, email string) (*platformv1.OrgMember, *ApiError) {
+	response, apiError := invoke(ctx, p, "GetOrganizationMember", read, p.Client.GetOrganizationMembers, &platformv1.GetOrganizationMembersRequest{
+		Pagination: &platformv1.Pagination{Limit: memberSearchLimit},
+		Search:     &email,
+	})
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	for _, member := range response.Members {
+		if strings.EqualFold(member.Email, email) {
+			return member, nil
+		}
+	}
+
+	return nil, &ApiError{Err: ErrNotFound, Reason: "GetOrganizationMember", Status: common.EnumStatusCode_ERR_NOT_FOUND}
+}
+
+// UpdateOrganizationMemberRole assigns the role to the member. The platform
+// expects the user performing the change alongside the member, which is the
+// user the API key belongs to. ErrNotFound is returned if that user is not a
+// member of the organization.
+func (p *PlatformClient) UpdateOrganizationMemberRole(ctx context.Context, memberUserId, role string) *ApiError {
+	self, apiError := p.ownUser(ctx)
+	if apiError != nil {
+		if IsNotFoundError(apiError) || IsUnknownOwnUserError(apiError) {
+			return &ApiError{Err: ErrNotFound, Reason: "UpdateOrganizationMemberRole", Status: common.EnumStatusCode_ERR_NOT_FOUND}
+		}
+		return apiError
+	}
+
+	_, apiError = invoke(ctx, p, "UpdateOrganizationMemberRole", write, p.Client.UpdateOrgMemberRole, &platformv1.UpdateOrgMemberRoleRequest{
+		UserID:          self.UserID,
+		OrgMemberUserID: memberUserId,
+		Role:            role,
+	})
+	return apiError
+}
+
+// RemoveOrganizationMember removes the user from the organization. Removing
+// the user the API key belongs to is refused, as it would lock the provider
+// out of the organization. If the platform does not tell which user that is,
+// every removal is refused with ErrUnknownOwnUser.
+func (p *PlatformClient) RemoveOrganizationMember(ctx context.Context, email string) *ApiError {
+	whoAmI, apiError := p.WhoAmI(ctx)
+	if apiError != nil {
+		return apiError
+	}
+	if whoAmI.GetUserEmail() == "" {
+		return &ApiError{Err: ErrUnknownOwnUser, Reason: "RemoveOrganizationMember", Status: common.EnumStatusCode_ERR}
+	}
+	if strings.EqualFold(whoAmI.GetUserEmail(), email) {
+		return &ApiError{Err: ErrRemovingOwnUser, Reason: "RemoveOrganizationMember", Status: common.EnumStatusCode_ERR}
+	}
+
+	_, apiError = invoke(ctx, p, "RemoveOrganizationMember", write, p.Client.RemoveOrganizationMember, &platformv1.RemoveOrganizationMemberRequest{
+		Email: email,
+	})
+	return apiError
+}
+
+// ownUser returns the member the API key belongs to.
+func (p *PlatformClient) ownUser(ctx context.Context) (*platformv1.OrgMember, *ApiError) {
+	whoAmI, apiError := p.WhoAmI(ctx)
+	if apiError != nil {
+		return nil, apiError
+	}
+	if whoAmI.GetUserEmail() == "" {
+		return nil, &ApiError{Err: ErrUnknownOwnUser, Reason: "WhoAmI", Status: common.EnumStatusCode_ERR}
+	}
+
+	return p.GetOrganizationMember(ctx, whoAmI.GetUserEmail())
+}
@@ -0,0 +1,113 @@
+package api_test
+
+import (
+	"context"
+	"testing"
+
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/fake"
+)
+
+func newFakeOrganization() *fake.PlatformServer {
+	server := fake.NewPlatformServer()
+	server.SetUser("owner@example.com")
+	server.AddOrganizationMember(&platformv1.OrgMember{UserID: "owner-id", Email: "owner@example.com", Roles: []string{api.OrganizationRoleAdmin}})
+	server.AddOrganizationMember(&platformv1.OrgMember{UserID: "member-id", Email: "member@example.com", Roles: []string{api.OrganizationRoleViewer}})
+
+	return server
+}
+
+func TestRemoveOrganizationMember(t *testing.T) {
+	server := newFakeOrganization()
+	client, err := api.NewClient("api_key", server.Start(t))
+	if err != nil {
+		t.Fatalf("Expected client to be created but got error: %v", err)
+	}
+
+	if apiErr := client.RemoveOrganizationMember(context.Background(), "member@example.com"); apiErr != nil {
+		t.Fatalf("Expected member to be removed but got error: %v", apiErr)
+	}
+
+	if _, apiErr := client.GetOrganizationMember(context.Background(), "member@example.com"); apiErr == nil || !api.IsNotFoundError(apiErr) {
+		t.Errorf("Expected member to be gone, got: %v", apiErr)
+	}
+}
+
+func TestRemoveOrganizationMemberRefusesOwnUser(t *testing.T) {
+	server := newFakeOrganization()
+	client, err := api.NewClient("api_key", server.Start(t))
+	if err != nil {
+		t.Fatalf("Expected client to be created but got error: %v", err)
+	}
+
+	apiErr := client.RemoveOrganizationMember(context.Background(), "Owner@Example.com")
+	if apiErr == nil || !api.IsRemovingOwnUserError(apiErr) {
+		t.Fatalf("Expected removing the own user to be refused, got: %v", apiErr)
+	}
+
+	if calls := server.Calls("RemoveOrganizationMember"); calls != 0 {
+		t.Errorf("Expected no removal to be sent, got: %d", calls)
+	}
+}
+
+func TestRemoveOrganizationMemberRefusesUnknownOwnUser(t *testing.T) {
+	server := newFakeOrganization()
+	server.SetUser("")
+	client, err := api.NewClient("api_key", server.Start(t))
+	if err != nil {
+		t.Fatalf("Expected client to be created but got error: %v", err)
+	}
+
+	apiErr := client.RemoveOrganizationMember(context.Background(), "member@example.com")
+	if apiErr == nil || !api.IsUnknownOwnUserError(apiErr) {
+		t.Fatalf("Expected the removal to be refused without knowing the own user, got: %v", apiErr)
+	}
+
+	if calls := server.Calls("RemoveOrganizationMember"); calls != 0 {
+		t.Errorf("Expected no removal to be sent, got: %d", calls)
+	}
+}
+
+func TestUpdateOrganizationMemberRole(t *testing.T) {
+	server := newFakeOrganization()
+	client, err := api.NewClient("api_key", server.Start(t))
+	if err != nil {
+		t.Fatalf("Expected client to be created but got error: %v", err)
+	}
+
+	if apiErr := client.UpdateOrganizationMemberRole(context.Background(), "member-id", api.OrganizationRoleDeveloper); apiErr != nil {
+		t.Fatalf("Expected role to be updated but got error: %v", apiErr)
+	}
+
+	member, apiErr := client.GetOrganizationMember(context.Background(), "member@example.com")
+	if apiErr != nil {
+		t.Fatalf("Expected member to be returned but got error: %v", apiErr)
+	}
+	if len(member.Roles) != 1 || member.Roles[0] != api.OrganizationRoleDeveloper {
+		t.Errorf("Expected role %s, got: %v", api.OrganizationRoleDeveloper, member.Roles)
+	}
+}
+
+func TestUpdateOrganizationMemberRoleWithoutOwnMember(t *testing.T) {
+	for name, user := range map[string]string{"unknown user": "", "not a member": "outsider@example.com"} {
+		t.Run(name, func(t *testing.T) {
+			server := newFakeOrganization()
+			server.SetUser(user)
+			client, err := api.NewClient("api_key", server.Start(t))
+			if err != nil {
+				t.Fatalf("Expected client to be created but got error: %v", err)
+			}
+
+			apiErr := client.UpdateOrganizationMemberRole(context.Background(), "member-id", api.OrganizationRoleDeveloper)
+			if apiErr == nil || !api.IsNotFoundError(apiErr) {
+				t.Fatalf("Expected the own member not to be found, got: %v", apiErr)
+			}
+
+			if calls := server.Calls("UpdateOrgMemberRole"); calls != 0 {
+				t.Errorf("Expected no role update to be sent, got: %d", calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"context"
+
+	"github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/common"
+	"github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/notifications"
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+)
+
+const (
+	OrganizationWebhookEventFederatedGraphSchemaUpdated = "FEDERATED_GRAPH_SCHEMA_UPDATED"
+	OrganizationWebhookEventMonographSchemaUpdated      = "MONOGRAPH_SCHEMA_UPDATED"
+)
+
+type OrganizationWebhook struct {
+	*platformv1.GetOrganizationWebhookConfigsResponse_Config
+	EventsMeta []*notifications.EventMeta
+}
+
+func (p *PlatformClient) CreateOrganizationWebhook(ctx context.Context, data *platformv1.CreateOrganizationWebhookConfigRequest) (string, *ApiError) {
+	response, apiError := invoke(ctx, p, "CreateOrganizationWebhook", write, p.Client.CreateOrganizationWebhookConfig, data)
+	if apiError != nil {
+		return "", apiError
+	}
+
+	return response.WebhookConfigId, nil
+}
+
+func (p *PlatformClient) UpdateOrganizationWebhook(ctx context.Context, data *platformv1.UpdateOrganizationWebhookConfigRequest) *ApiError {
+	_, apiError := invoke(ctx, p, "UpdateOrganizationWebhook", write, p.Client.UpdateOrganizationWebhookConfig, data)
+	return apiError
+}
+
+func (p *PlatformClient) DeleteOrganizationWebhook(ctx context.Context, id string) *ApiError {
+	_, apiError := invoke(ctx, p, "DeleteOrganizationWebhook", write, p.Client.DeleteOrganizationWebhookConfig, &platformv1.DeleteOrganizationWebhookConfigRequest{
+		Id: id,
+	})
+	return apiError
+}
+
+// GetOrganizationWebhook returns the webhook together with the graphs its
+// events are filtered by. The platform only lists all webhooks of the
+// organization, the one with the given id is picked from the list.
+func (p *PlatformClient) GetOrganizationWebhook(ctx context.Context, id string) (*OrganizationWebhook, *ApiError) {
+	response, apiError := invoke(ctx, p, "GetOrganizationWebhook", read, p.Client.GetOrganizationWebhookConfigs, &platformv1.GetOrganizationWebhookConfigsRequest{})
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	var config *platformv1.GetOrganizationWebhookConfigsResponse_Config
+	for _, c := range response.Configs {
+		if c.Id == id {
+			config = c
+			break
+		}
+	}
+	if config == nil {
+		return nil, &ApiError{Err: ErrNotFound, Reason: "GetOrganizationWebhook", Status: common.EnumStatusCode_ERR_NOT_FOUND}
+	}
+
+	meta, apiError := invoke(ctx, p, "GetOrganizationWebhookMeta", read, p.Client.GetOrganizationWebhookMeta, &platformv1.GetOrganizationWebhookMetaRequest{
+		Id: id,
+	})
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	return &OrganizationWebhook{
+		GetOrganizationWebhookConfigsResponse_Config: config,
+		EventsMeta: meta.EventsMeta,
+	}, nil
+}
@@ -0,0 +1,75 @@
+package api
+
+import (
+	"context"
+
+	"github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/common"
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+)
+
+const (
+	PersistedOperationStatusCreated  = "created"
+	PersistedOperationStatusUpToDate = "up_to_date"
+	PersistedOperationStatusConflict = "conflict"
+)
+
+func PersistedOperationStatusString(status platformv1.PublishedOperationStatus) string {
+	switch status {
+	case platformv1.PublishedOperationStatus_CREATED:
+		return PersistedOperationStatusCreated
+	case platformv1.PublishedOperationStatus_CONFLICT:
+		return PersistedOperationStatusConflict
+	// platformv1.PublishedOperationStatus_UP_TO_DATE
+	default:
+		return PersistedOperationStatusUpToDate
+	}
+}
+
+func (p *PlatformClient) PublishPersistedOperations(ctx context.Context, graphName, namespace, clientName string, operations []*platformv1.PersistedOperation) ([]*platformv1.PublishedOperation, *ApiError) {
+	response, apiError := invoke(ctx, p, "PublishPersistedOperations", write, p.Client.PublishPersistedOperations, &platformv1.PublishPersistedOperationsRequest{
+		FedGraphName: graphName,
+		Namespace:    namespace,
+		ClientName:   clientName,
+		Operations:   operations,
+	})
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	return response.Operations, nil
+}
+
+// GetPersistedOperations returns the operations persisted for the client of
+// the federated graph. It returns ErrNotFound if the client does not exist,
+// which is the case until the first operation was published for it.
+func (p *PlatformClient) GetPersistedOperations(ctx context.Context, graphName, namespace, clientName string) ([]*platformv1.GetPersistedOperationsResponse_Operation, *ApiError) {
+	clients, apiError := invoke(ctx, p, "GetClients", read, p.Client.GetClients, &platformv1.GetClientsRequest{
+		FedGraphName: graphName,
+		Namespace:    namespace,
+	})
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	var clientId string
+	for _, client := range clients.Clients {
+		if client.Name == clientName {
+			clientId = client.Id
+			break
+		}
+	}
+	if clientId == "" {
+		return nil, &ApiError{Err: ErrNotFound, Reason: "GetPersistedOperations", Status: common.EnumStatusCode_ERR_NOT_FOUND}
+	}
+
+	response, apiError := invoke(ctx, p, "GetPersistedOperations", read, p.Client.GetPersistedOperations, &platformv1.GetPersistedOperationsRequest{
+		FederatedGraphName: graphName,
+		Namespace:          namespace,
+		ClientId:           clientId,
+	})
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	return response.Operations, nil
+}
@@ -0,0 +1,122 @@
+package api
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/common"
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+)
+
+const (
+	PlaygroundScriptTypePreFlight     = "pre-flight"
+	PlaygroundScriptTypePreOperation  = "pre-operation"
+	PlaygroundScriptTypePostOperation = "post-operation"
+)
+
+var PlaygroundScriptTypes = []string{
+	PlaygroundScriptTypePreFlight,
+	PlaygroundScriptTypePreOperation,
+	PlaygroundScriptTypePostOperation,
+}
+
+// CreatePlaygroundScript creates the script and returns it. The platform does
+// not return the id of a new script, it is the one script of the type and
+// title which did not exist before. Creates are serialized per type, so that
+// concurrent creates don't take each other's scripts.
+func (p *PlatformClient) CreatePlaygroundScript(ctx context.Context, title, scriptType, content string) (*platformv1.PlaygroundScript, *ApiError) {
+	lock, _ := p.scriptCreations.LoadOrStore(scriptType, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	existing, apiError := p.GetPlaygroundScripts(ctx, scriptType)
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	_, apiError = invoke(ctx, p, "CreatePlaygroundScript", write, p.Client.CreatePlaygroundScript, &platformv1.CreatePlaygroundScriptRequest{
+		Title:   title,
+		Type:    scriptType,
+		Content: content,
+	})
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	scripts, apiError := p.GetPlaygroundScripts(ctx, scriptType)
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	var created []*platformv1.PlaygroundScript
+	for _, script := range scripts {
+		isNew := !slices.ContainsFunc(existing, func(other *platformv1.PlaygroundScript) bool {
+			return other.Id == script.Id
+		})
+		if isNew && script.Title == title {
+			created = append(created, script)
+		}
+	}
+
+	switch len(created) {
+	case 0:
+		return nil, &ApiError{Err: ErrNotFound, Reason: "CreatePlaygroundScript", Status: common.EnumStatusCode_ERR_NOT_FOUND}
+	case 1:
+		return created[0], nil
+	default:
+		// Another client created a script with the same title meanwhile.
+		return nil, &ApiError{Err: ErrAmbiguousPlaygroundScript, Reason: "CreatePlaygroundScript", Status: common.EnumStatusCode_ERR}
+	}
+}
+
+func (p *PlatformClient) UpdatePlaygroundScript(ctx context.Context, id, title, content string) *ApiError {
+	_, apiError := invoke(ctx, p, "UpdatePlaygroundScript", write, p.Client.UpdatePlaygroundScript, &platformv1.UpdatePlaygroundScriptRequest{
+		Id:      id,
+		Title:   title,
+		Content: content,
+	})
+	return apiError
+}
+
+func (p *PlatformClient) DeletePlaygroundScript(ctx context.Context, id string) *ApiError {
+	_, apiError := invoke(ctx, p, "DeletePlaygroundScript", write, p.Client.DeletePlaygroundScript, &platformv1.DeletePlaygroundScriptRequest{
+		Id: id,
+	})
+	return apiError
+}
+
+func (p *PlatformClient) GetPlaygroundScripts(ctx context.Context, scriptType string) ([]*platformv1.PlaygroundScript, *ApiError) {
+	response, apiError := invoke(ctx, p, "GetPlaygroundScripts", read, p.Client.GetPlaygroundScripts, &platformv1.GetPlaygroundScriptsRequest{
+		Type: scriptType,
+	})
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	return response.Scripts, nil
+}
+
+// GetPlaygroundScript returns the script with the id. Scripts are listed per
+// type, without a type all of them are searched.
+func (p *PlatformClient) GetPlaygroundScript(ctx context.Context, id, scriptType string) (*platformv1.PlaygroundScript, *ApiError) {
+	types := PlaygroundScriptTypes
+	if scriptType != "" {
+		types = []string{scriptType}
+	}
+
+	for _, scriptType := range types {
+		scripts, apiError := p.GetPlaygroundScripts(ctx, scriptType)
+		if apiError != nil {
+			return nil, apiError
+		}
+
+		for _, script := range scripts {
+			if script.Id == id {
+				return script, nil
+			}
+		}
+	}
+
+	return nil, &ApiError{Err: ErrNotFound, Reason: "GetPlaygroundScript", Status: common.EnumStatusCode_ERR_NOT_FOUND}
+}
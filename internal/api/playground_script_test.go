@@ -0,0 +1,45 @@
+package api_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/fake"
+)
+
+func TestCreatePlaygroundScriptConcurrently(t *testing.T) {
+	server := fake.NewPlatformServer()
+	client, err := api.NewClient("api_key", server.Start(t))
+	if err != nil {
+		t.Fatalf("Expected client to be created but got error: %v", err)
+	}
+
+	// Scripts of the same type and title, as created by parallel resources.
+	const scripts = 10
+	ids := make([]string, scripts)
+
+	var wg sync.WaitGroup
+	for i := 0; i < scripts; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			script, apiErr := client.CreatePlaygroundScript(context.Background(), "auth", api.PlaygroundScriptTypePreFlight, "")
+			if apiErr != nil {
+				t.Errorf("Expected script to be created but got error: %v", apiErr)
+				return
+			}
+			ids[i] = script.GetId()
+		}(i)
+	}
+	wg.Wait()
+
+	seen := map[string]bool{}
+	for _, id := range ids {
+		if seen[id] {
+			t.Errorf("Expected every create to return its own script, got %s twice", id)
+		}
+		seen[id] = true
+	}
+}
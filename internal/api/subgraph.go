@@ -3,158 +3,113 @@ package api
 import (
 	"context"
 
-	"connectrpc.com/connect"
-
-	"github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/common"
 	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
 )
 
 func (p *PlatformClient) CreateSubgraph(ctx context.Context, data *platformv1.CreateFederatedSubgraphRequest) *ApiError {
-	request := connect.NewRequest(data)
-	response, err := p.Client.CreateFederatedSubgraph(ctx, request)
-	if err != nil {
-		return &ApiError{Err: err, Reason: "CreateSubgraph", Status: common.EnumStatusCode_ERR}
-	}
-
-	if response.Msg == nil {
-		return &ApiError{Err: ErrEmptyMsg, Reason: "CreateSubgraph", Status: common.EnumStatusCode_ERR}
+	unlock, lockErr := p.compositions.lock(ctx, data.Namespace)
+	if lockErr != nil {
+		return lockErr
 	}
+	defer unlock()
+	defer p.cache.invalidate(data.Namespace)
 
-	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
-	if apiError != nil {
-		return apiError
-	}
-
-	return nil
+	_, apiError := invoke(ctx, p, "CreateSubgraph", write, p.Client.CreateFederatedSubgraph, data)
+	return apiError
 }
 
 func (p *PlatformClient) UpdateSubgraph(ctx context.Context, data *platformv1.UpdateSubgraphRequest) *ApiError {
-	request := connect.NewRequest(data)
-
-	response, err := p.Client.UpdateSubgraph(ctx, request)
-	if err != nil {
-		return &ApiError{Err: err, Reason: "UpdateSubgraph", Status: common.EnumStatusCode_ERR}
+	unlock, lockErr := p.compositions.lock(ctx, data.Namespace)
+	if lockErr != nil {
+		return lockErr
 	}
+	defer unlock()
+	defer p.cache.invalidate(data.Namespace)
 
-	if response.Msg == nil {
-		return &ApiError{Err: ErrEmptyMsg, Reason: "UpdateSubgraph", Status: common.EnumStatusCode_ERR}
-	}
-
-	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
-	if apiError != nil {
-		return apiError
-	}
-
-	return nil
+	_, apiError := invoke(ctx, p, "UpdateSubgraph", write, p.Client.UpdateSubgraph, data)
+	return apiError
 }
 
 func (p *PlatformClient) DeleteSubgraph(ctx context.Context, name, namespace string) *ApiError {
-	request := connect.NewRequest(&platformv1.DeleteFederatedSubgraphRequest{
+	unlock, lockErr := p.compositions.lock(ctx, namespace)
+	if lockErr != nil {
+		return lockErr
+	}
+	defer unlock()
+	defer p.cache.invalidate(namespace)
+
+	_, apiError := invoke(ctx, p, "DeleteSubgraph", write, p.Client.DeleteFederatedSubgraph, &platformv1.DeleteFederatedSubgraphRequest{
 		SubgraphName: name,
 		Namespace:    namespace,
 	})
-	response, err := p.Client.DeleteFederatedSubgraph(ctx, request)
-	if err != nil {
-		return &ApiError{Err: err, Reason: "DeleteSubgraph", Status: common.EnumStatusCode_ERR}
-	}
-
-	if response.Msg == nil {
-		return &ApiError{Err: ErrEmptyMsg, Reason: "DeleteSubgraph", Status: common.EnumStatusCode_ERR}
-	}
-
-	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
-	if apiError != nil {
-		return apiError
-	}
-
-	return nil
+	return apiError
 }
 
 func (p *PlatformClient) GetSubgraph(ctx context.Context, name, namespace string) (*platformv1.Subgraph, *ApiError) {
-	request := connect.NewRequest(&platformv1.GetSubgraphByNameRequest{
+	if subgraph, ok := p.namespace(ctx, namespace).subgraph(name); ok {
+		return subgraph, nil
+	}
+
+	response, apiError := invoke(ctx, p, "GetSubgraph", read, p.Client.GetSubgraphByName, &platformv1.GetSubgraphByNameRequest{
 		Name:      name,
 		Namespace: namespace,
 	})
-	response, err := p.Client.GetSubgraphByName(ctx, request)
-	if err != nil {
-		return nil, &ApiError{Err: err, Reason: "GetSubgraph", Status: common.EnumStatusCode_ERR}
-	}
-
-	if response.Msg == nil {
-		return nil, &ApiError{Err: ErrEmptyMsg, Reason: "GetSubgraph", Status: common.EnumStatusCode_ERR}
-	}
-
-	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
 	if apiError != nil {
 		return nil, apiError
 	}
 
-	return response.Msg.GetGraph(), nil
+	return response.GetGraph(), nil
 }
 
 func (p *PlatformClient) GetSubgraphById(ctx context.Context, id string) (*platformv1.Subgraph, *ApiError) {
-	request := connect.NewRequest(&platformv1.GetSubgraphByIdRequest{
-		Id: id,
-	})
-	response, err := p.Client.GetSubgraphById(ctx, request)
-	if err != nil {
-		return nil, &ApiError{Err: err, Reason: "GetSubgraph", Status: common.EnumStatusCode_ERR}
+	if subgraph, ok := p.cache.subgraphById(id); ok {
+		return subgraph, nil
 	}
 
-	if response.Msg == nil {
-		return nil, &ApiError{Err: ErrEmptyMsg, Reason: "GetSubgraph", Status: common.EnumStatusCode_ERR}
-	}
-
-	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
+	response, apiError := invoke(ctx, p, "GetSubgraphById", read, p.Client.GetSubgraphById, &platformv1.GetSubgraphByIdRequest{
+		Id: id,
+	})
 	if apiError != nil {
 		return nil, apiError
 	}
 
-	return response.Msg.GetGraph(), nil
+	// Load the namespace of the subgraph, so that the other graphs and
+	// subgraphs of the namespace are served from the cache.
+	p.namespace(ctx, response.GetGraph().GetNamespace())
+
+	return response.GetGraph(), nil
 }
 
 func (p *PlatformClient) GetSubgraphSchema(ctx context.Context, name, namespace string) (string, *ApiError) {
-	request := connect.NewRequest(&platformv1.GetLatestSubgraphSDLRequest{
+	entry := p.namespace(ctx, namespace)
+	if schema, ok := entry.subgraphSchema(name); ok {
+		return schema, nil
+	}
+
+	response, apiError := invoke(ctx, p, "GetSubgraphSchema", read, p.Client.GetLatestSubgraphSDL, &platformv1.GetLatestSubgraphSDLRequest{
 		Name:      name,
 		Namespace: namespace,
 	})
-
-	response, err := p.Client.GetLatestSubgraphSDL(ctx, request)
-	if err != nil {
-		return "", &ApiError{Err: err, Reason: "GetSubgraph", Status: common.EnumStatusCode_ERR}
-	}
-
-	if response.Msg == nil {
-		return "", &ApiError{Err: ErrEmptyMsg, Reason: "GetSubgraph", Status: common.EnumStatusCode_ERR}
-	}
-
-	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
 	if apiError != nil {
 		return "", apiError
 	}
 
-	return response.Msg.GetSdl(), nil
+	entry.setSubgraphSchema(name, response.GetSdl())
+
+	return response.GetSdl(), nil
 }
 
 func (p *PlatformClient) PublishSubgraph(ctx context.Context, name, namespace, schema string) (*platformv1.PublishFederatedSubgraphResponse, *ApiError) {
-	request := connect.NewRequest(&platformv1.PublishFederatedSubgraphRequest{
+	unlock, lockErr := p.compositions.lock(ctx, namespace)
+	if lockErr != nil {
+		return nil, lockErr
+	}
+	defer unlock()
+	defer p.cache.invalidate(namespace)
+
+	return invoke(ctx, p, "PublishSubgraph", write, p.Client.PublishFederatedSubgraph, &platformv1.PublishFederatedSubgraphRequest{
 		Name:      name,
 		Namespace: namespace,
 		Schema:    schema,
 	})
-	response, err := p.Client.PublishFederatedSubgraph(ctx, request)
-	if err != nil {
-		return nil, &ApiError{Err: err, Reason: "PublishSubgraph", Status: common.EnumStatusCode_ERR}
-	}
-
-	if response.Msg == nil {
-		return nil, &ApiError{Err: ErrEmptyMsg, Reason: "PublishSubgraph", Status: common.EnumStatusCode_ERR}
-	}
-
-	apiError := handleErrorCodes(response.Msg.GetResponse().Code, response.Msg.String())
-	if apiError != nil {
-		return nil, apiError
-	}
-
-	return response.Msg, nil
 }
@@ -0,0 +1,56 @@
+package api
+
+import (
+	"context"
+	"strings"
+
+	"github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/common"
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+)
+
+func (p *PlatformClient) AddSubgraphMember(ctx context.Context, subgraphName, namespace, email string) *ApiError {
+	_, apiError := invoke(ctx, p, "AddSubgraphMember", write, p.Client.AddSubgraphMember, &platformv1.AddSubgraphMemberRequest{
+		SubgraphName: subgraphName,
+		Namespace:    namespace,
+		UserEmail:    email,
+	})
+	return apiError
+}
+
+func (p *PlatformClient) RemoveSubgraphMember(ctx context.Context, subgraphName, namespace, memberId string) *ApiError {
+	_, apiError := invoke(ctx, p, "RemoveSubgraphMember", write, p.Client.RemoveSubgraphMember, &platformv1.RemoveSubgraphMemberRequest{
+		SubgraphName:     subgraphName,
+		Namespace:        namespace,
+		SubgraphMemberId: memberId,
+	})
+	return apiError
+}
+
+func (p *PlatformClient) GetSubgraphMembers(ctx context.Context, subgraphName, namespace string) ([]*platformv1.SubgraphMember, *ApiError) {
+	response, apiError := invoke(ctx, p, "GetSubgraphMembers", read, p.Client.GetSubgraphMembers, &platformv1.GetSubgraphMembersRequest{
+		SubgraphName: subgraphName,
+		Namespace:    namespace,
+	})
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	return response.Members, nil
+}
+
+// GetSubgraphMember returns the member of the subgraph with the email, which
+// is compared case-insensitively like the platform does when adding members.
+func (p *PlatformClient) GetSubgraphMember(ctx context.Context, subgraphName, namespace, email string) (*platformv1.SubgraphMember, *ApiError) {
+	members, apiError := p.GetSubgraphMembers(ctx, subgraphName, namespace)
+	if apiError != nil {
+		return nil, apiError
+	}
+
+	for _, member := range members {
+		if strings.EqualFold(member.Email, email) {
+			return member, nil
+		}
+	}
+
+	return nil, &ApiError{Err: ErrNotFound, Reason: "GetSubgraphMember", Status: common.EnumStatusCode_ERR_NOT_FOUND}
+}
@@ -0,0 +1,68 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/common"
+	"github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1/platformv1connect"
+)
+
+const tracerName = "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+
+var (
+	// MethodKey is the PlatformClient method of a span, e.g. "GetSubgraph".
+	MethodKey = attribute.Key("cosmo.client.method")
+	// StatusKey is the status code the platform answered with, e.g. "OK" or
+	// "ERR_NOT_FOUND".
+	StatusKey = attribute.Key("cosmo.status_code")
+)
+
+type outgoingHeaderKey struct{}
+
+// TracingInterceptor starts a client span for every call of the
+// PlatformClient and propagates its context to the platform through the
+// propagator, e.g. as the traceparent header. With the no-op tracer provider
+// and propagator OpenTelemetry uses unless tracing was set up, it does nothing.
+func TracingInterceptor(tracerProvider trace.TracerProvider, propagator propagation.TextMapPropagator) Interceptor {
+	tracer := tracerProvider.Tracer(tracerName)
+
+	return func(ctx context.Context, method string, next func(context.Context) *ApiError) *ApiError {
+		ctx, span := tracer.Start(ctx, "PlatformClient."+method,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				semconv.RPCSystemKey.String("connect_rpc"),
+				semconv.RPCService(platformv1connect.PlatformServiceName),
+				MethodKey.String(method),
+			),
+		)
+		defer span.End()
+
+		header := http.Header{}
+		propagator.Inject(ctx, propagation.HeaderCarrier(header))
+
+		apiErr := next(context.WithValue(ctx, outgoingHeaderKey{}, header))
+		if apiErr != nil {
+			span.SetAttributes(StatusKey.String(apiErr.Status.String()))
+			span.RecordError(apiErr)
+			span.SetStatus(codes.Error, apiErr.Error())
+			return apiErr
+		}
+
+		span.SetAttributes(StatusKey.String(common.EnumStatusCode_OK.String()))
+		return nil
+	}
+}
+
+// outgoingHeader returns the headers an interceptor added for the requests
+// sent with the context.
+func outgoingHeader(ctx context.Context) http.Header {
+	header, _ := ctx.Value(outgoingHeaderKey{}).(http.Header)
+	return header
+}
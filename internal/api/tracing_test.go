@@ -0,0 +1,115 @@
+package api_test
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+)
+
+func newTracedClient(t *testing.T, url string) (*api.PlatformClient, *tracetest.InMemoryExporter, trace.Tracer) {
+	exporter := tracetest.NewInMemoryExporter()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	t.Cleanup(func() { _ = tracerProvider.Shutdown(context.Background()) })
+
+	client, err := api.NewClient("api_key", url,
+		api.WithReadCache(false),
+		api.WithInterceptor(api.TracingInterceptor(tracerProvider, propagation.TraceContext{})),
+	)
+	if err != nil {
+		t.Fatalf("Expected client to be created but got error: %v", err)
+	}
+
+	return client, exporter, tracerProvider.Tracer("test")
+}
+
+func TestTracingInterceptorRecordsSpanAndPropagatesContext(t *testing.T) {
+	server := newFakeServer(1)
+	client, exporter, tracer := newTracedClient(t, server.Start(t))
+
+	ctx, parent := tracer.Start(context.Background(), "cosmo_subgraph read")
+	if _, apiErr := client.GetSubgraph(ctx, "subgraph-0", fakeNamespace); apiErr != nil {
+		t.Fatalf("Expected subgraph to be returned but got error: %v", apiErr)
+	}
+	parent.End()
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("Expected the call and its parent to be recorded, got: %d spans", len(spans))
+	}
+
+	span := spans[0]
+	if span.Name != "PlatformClient.GetSubgraph" {
+		t.Errorf("Expected span PlatformClient.GetSubgraph, got: %s", span.Name)
+	}
+	if span.SpanKind != trace.SpanKindClient {
+		t.Errorf("Expected a client span, got: %s", span.SpanKind)
+	}
+	if span.Parent.SpanID() != parent.SpanContext().SpanID() {
+		t.Errorf("Expected the span to be a child of the resource span")
+	}
+
+	attributes := map[string]string{}
+	for _, attr := range span.Attributes {
+		attributes[string(attr.Key)] = attr.Value.Emit()
+	}
+	if attributes[string(api.MethodKey)] != "GetSubgraph" {
+		t.Errorf("Expected method GetSubgraph, got: %q", attributes[string(api.MethodKey)])
+	}
+	if attributes[string(api.StatusKey)] != "OK" {
+		t.Errorf("Expected status OK, got: %q", attributes[string(api.StatusKey)])
+	}
+
+	traceparent := server.Header("GetSubgraphByName").Get("traceparent")
+	expected := "00-" + span.SpanContext.TraceID().String() + "-" + span.SpanContext.SpanID().String() + "-01"
+	if traceparent != expected {
+		t.Errorf("Expected traceparent %s, got: %q", expected, traceparent)
+	}
+}
+
+func TestTracingInterceptorRecordsErrors(t *testing.T) {
+	server := newFakeServer(0)
+	client, exporter, _ := newTracedClient(t, server.Start(t))
+
+	if _, apiErr := client.GetSubgraph(context.Background(), "unknown", fakeNamespace); apiErr == nil {
+		t.Fatal("Expected the lookup of an unknown subgraph to fail")
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("Expected one span, got: %d", len(spans))
+	}
+	if spans[0].Status.Code != codes.Error {
+		t.Errorf("Expected an error status, got: %s", spans[0].Status.Code)
+	}
+	for _, attr := range spans[0].Attributes {
+		if attr.Key == api.StatusKey && attr.Value.Emit() != "ERR_NOT_FOUND" {
+			t.Errorf("Expected status ERR_NOT_FOUND, got: %s", attr.Value.Emit())
+		}
+	}
+}
+
+func TestTracingInterceptorWithoutTracing(t *testing.T) {
+	server := newFakeServer(1)
+	client, err := api.NewClient("api_key", server.Start(t),
+		api.WithInterceptor(api.TracingInterceptor(noop.NewTracerProvider(), propagation.NewCompositeTextMapPropagator())),
+	)
+	if err != nil {
+		t.Fatalf("Expected client to be created but got error: %v", err)
+	}
+
+	if _, apiErr := client.GetSubgraph(context.Background(), "subgraph-0", fakeNamespace); apiErr != nil {
+		t.Fatalf("Expected subgraph to be returned but got error: %v", apiErr)
+	}
+
+	if traceparent := server.Header("GetSubgraphByName").Get("traceparent"); traceparent != "" {
+		t.Errorf("Expected no traceparent, got: %s", traceparent)
+	}
+}
@@ -0,0 +1,509 @@
+// Package fake provides an in-process implementation of the parts of the
+// Cosmo platform API used by the provider, for tests and benchmarks which
+// should not depend on a running control plane.
+package fake
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/common"
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+	"github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1/platformv1connect"
+)
+
+// PlatformServer keeps graphs, subgraphs, their schemas, the members of the
+// organization, playground scripts and graphs of Apollo GraphOS to migrate in
+// memory. It counts the calls per procedure and keeps the headers of the last
+// call. Procedures that are not implemented return connect.CodeUnimplemented.
+type PlatformServer struct {
+	platformv1connect.UnimplementedPlatformServiceHandler
+
+	mu        sync.Mutex
+	calls     map[string]int
+	headers   map[string]http.Header
+	failures  map[string][]connect.Code
+	graphs    map[string]map[string]*platformv1.FederatedGraph
+	subgraphs map[string]map[string]*platformv1.Subgraph
+	schemas   map[string]map[string]string
+	user      string
+	members   map[string]*platformv1.OrgMember
+	// graphSubgraphs holds the names of the subgraphs of federated graphs by
+	// namespace and graph name.
+	graphSubgraphs map[string]map[string][]string
+	apolloGraphs   map[string]apolloGraph
+	opaqueTokens   bool
+	scripts        []*platformv1.PlaygroundScript
+}
+
+// apolloGraph is a variant of a graph of Apollo GraphOS, which is migrated
+// with the API key it was added with.
+type apolloGraph struct {
+	variant   string
+	graph     *platformv1.FederatedGraph
+	subgraphs []*platformv1.Subgraph
+}
+
+func NewPlatformServer() *PlatformServer {
+	return &PlatformServer{
+		calls:     map[string]int{},
+		headers:   map[string]http.Header{},
+		failures:  map[string][]connect.Code{},
+		graphs:    map[string]map[string]*platformv1.FederatedGraph{},
+		subgraphs: map[string]map[string]*platformv1.Subgraph{},
+		schemas:   map[string]map[string]string{},
+		members:   map[string]*platformv1.OrgMember{},
+
+		graphSubgraphs: map[string]map[string][]string{},
+		apolloGraphs:   map[string]apolloGraph{},
+	}
+}
+
+// Start serves the fake over HTTP until the test finishes and returns its URL.
+func (s *PlatformServer) Start(tb testing.TB) string {
+	tb.Helper()
+
+	mux := http.NewServeMux()
+	mux.Handle(platformv1connect.NewPlatformServiceHandler(s))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.headers[path.Base(r.URL.Path)] = r.Header.Clone()
+		s.mu.Unlock()
+
+		mux.ServeHTTP(w, r)
+	}))
+	tb.Cleanup(server.Close)
+
+	return server.URL
+}
+
+// Calls returns how often the procedure, e.g. "GetSubgraphByName", was called.
+func (s *PlatformServer) Calls(procedure string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.calls[procedure]
+}
+
+// Header returns the headers of the last call of the procedure.
+func (s *PlatformServer) Header(procedure string) http.Header {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.headers[procedure]
+}
+
+// Fail makes the next calls of the procedure fail with the given codes, one
+// code per call.
+func (s *PlatformServer) Fail(procedure string, codes ...connect.Code) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failures[procedure] = append(s.failures[procedure], codes...)
+}
+
+func (s *PlatformServer) AddFederatedGraph(graph *platformv1.FederatedGraph) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.graphs[graph.GetNamespace()] == nil {
+		s.graphs[graph.GetNamespace()] = map[string]*platformv1.FederatedGraph{}
+	}
+	s.graphs[graph.GetNamespace()][graph.GetName()] = graph
+}
+
+func (s *PlatformServer) AddSubgraph(subgraph *platformv1.Subgraph, schema string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.subgraphs[subgraph.GetNamespace()] == nil {
+		s.subgraphs[subgraph.GetNamespace()] = map[string]*platformv1.Subgraph{}
+		s.schemas[subgraph.GetNamespace()] = map[string]string{}
+	}
+	s.subgraphs[subgraph.GetNamespace()][subgraph.GetName()] = subgraph
+	s.schemas[subgraph.GetNamespace()][subgraph.GetName()] = schema
+}
+
+// SetUser sets the email of the user the API key belongs to, as reported by
+// WhoAmI. WhoAmI reports no email until it is set.
+func (s *PlatformServer) SetUser(email string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.user = email
+}
+
+func (s *PlatformServer) AddOrganizationMember(member *platformv1.OrgMember) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.members[member.GetEmail()] = member
+}
+
+// AddApolloGraph adds a variant of a graph of Apollo GraphOS, which
+// MigrateFromApollo migrates with the API key into the requested namespace.
+func (s *PlatformServer) AddApolloGraph(apiKey, variant string, graph *platformv1.FederatedGraph, subgraphs ...*platformv1.Subgraph) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.apolloGraphs[apiKey] = apolloGraph{variant: variant, graph: graph, subgraphs: subgraphs}
+}
+
+// SetOpaqueRouterTokens makes MigrateFromApollo return router tokens which do
+// not carry the id of the federated graph, unlike the JWTs of RouterToken.
+func (s *PlatformServer) SetOpaqueRouterTokens() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.opaqueTokens = true
+}
+
+// RouterToken returns the router token of the federated graph, an unsigned
+// JWT carrying the id of the graph like the tokens of the platform.
+func RouterToken(graphId string) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"federated_graph_id":%q,"organization_id":"organization-id"}`, graphId)))
+	return header + "." + payload + ".signature"
+}
+
+// record counts the call and returns the error the call should fail with, if
+// any was queued by Fail.
+func (s *PlatformServer) record(procedure string) error {
+	s.calls[procedure]++
+
+	if failures := s.failures[procedure]; len(failures) > 0 {
+		s.failures[procedure] = failures[1:]
+		return connect.NewError(failures[0], nil)
+	}
+
+	return nil
+}
+
+// graphSubgraphsOf returns the subgraphs composed into the federated graph.
+func (s *PlatformServer) graphSubgraphsOf(graph *platformv1.FederatedGraph) []*platformv1.Subgraph {
+	var subgraphs []*platformv1.Subgraph
+	for _, name := range s.graphSubgraphs[graph.GetNamespace()][graph.GetName()] {
+		if subgraph, found := s.subgraphs[graph.GetNamespace()][name]; found {
+			subgraphs = append(subgraphs, subgraph)
+		}
+	}
+	return subgraphs
+}
+
+func ok() *platformv1.Response {
+	return &platformv1.Response{Code: common.EnumStatusCode_OK}
+}
+
+func notFound() *platformv1.Response {
+	return &platformv1.Response{Code: common.EnumStatusCode_ERR_NOT_FOUND}
+}
+
+func (s *PlatformServer) GetFederatedGraphs(_ context.Context, req *connect.Request[platformv1.GetFederatedGraphsRequest]) (*connect.Response[platformv1.GetFederatedGraphsResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.record("GetFederatedGraphs"); err != nil {
+		return nil, err
+	}
+
+	// Like the platform, the list leaves out fields which only the by name
+	// and by ID endpoints return.
+	graphs := make([]*platformv1.FederatedGraph, 0, len(s.graphs[req.Msg.GetNamespace()]))
+	for _, graph := range s.graphs[req.Msg.GetNamespace()] {
+		listed := proto.Clone(graph).(*platformv1.FederatedGraph)
+		listed.Readme = nil
+		graphs = append(graphs, listed)
+	}
+
+	return connect.NewResponse(&platformv1.GetFederatedGraphsResponse{Response: ok(), Graphs: graphs}), nil
+}
+
+func (s *PlatformServer) GetFederatedGraphByName(_ context.Context, req *connect.Request[platformv1.GetFederatedGraphByNameRequest]) (*connect.Response[platformv1.GetFederatedGraphByNameResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.record("GetFederatedGraphByName"); err != nil {
+		return nil, err
+	}
+
+	graph, found := s.graphs[req.Msg.GetNamespace()][req.Msg.GetName()]
+	if !found {
+		return connect.NewResponse(&platformv1.GetFederatedGraphByNameResponse{Response: notFound()}), nil
+	}
+
+	return connect.NewResponse(&platformv1.GetFederatedGraphByNameResponse{Response: ok(), Graph: graph, Subgraphs: s.graphSubgraphsOf(graph)}), nil
+}
+
+func (s *PlatformServer) GetFederatedGraphById(_ context.Context, req *connect.Request[platformv1.GetFederatedGraphByIdRequest]) (*connect.Response[platformv1.GetFederatedGraphByIdResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.record("GetFederatedGraphById"); err != nil {
+		return nil, err
+	}
+
+	for _, graphs := range s.graphs {
+		for _, graph := range graphs {
+			if graph.GetId() == req.Msg.GetId() {
+				return connect.NewResponse(&platformv1.GetFederatedGraphByIdResponse{Response: ok(), Graph: graph, Subgraphs: s.graphSubgraphsOf(graph)}), nil
+			}
+		}
+	}
+
+	return connect.NewResponse(&platformv1.GetFederatedGraphByIdResponse{Response: notFound()}), nil
+}
+
+func (s *PlatformServer) GetSubgraphs(_ context.Context, req *connect.Request[platformv1.GetSubgraphsRequest]) (*connect.Response[platformv1.GetSubgraphsResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.record("GetSubgraphs"); err != nil {
+		return nil, err
+	}
+
+	subgraphs := make([]*platformv1.Subgraph, 0, len(s.subgraphs[req.Msg.GetNamespace()]))
+	for _, subgraph := range s.subgraphs[req.Msg.GetNamespace()] {
+		listed := proto.Clone(subgraph).(*platformv1.Subgraph)
+		listed.Readme = nil
+		subgraphs = append(subgraphs, listed)
+	}
+
+	return connect.NewResponse(&platformv1.GetSubgraphsResponse{Response: ok(), Graphs: subgraphs, Count: int32(len(subgraphs))}), nil
+}
+
+func (s *PlatformServer) GetSubgraphByName(_ context.Context, req *connect.Request[platformv1.GetSubgraphByNameRequest]) (*connect.Response[platformv1.GetSubgraphByNameResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.record("GetSubgraphByName"); err != nil {
+		return nil, err
+	}
+
+	subgraph, found := s.subgraphs[req.Msg.GetNamespace()][req.Msg.GetName()]
+	if !found {
+		return connect.NewResponse(&platformv1.GetSubgraphByNameResponse{Response: notFound()}), nil
+	}
+
+	return connect.NewResponse(&platformv1.GetSubgraphByNameResponse{Response: ok(), Graph: subgraph}), nil
+}
+
+func (s *PlatformServer) GetSubgraphById(_ context.Context, req *connect.Request[platformv1.GetSubgraphByIdRequest]) (*connect.Response[platformv1.GetSubgraphByIdResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.record("GetSubgraphById"); err != nil {
+		return nil, err
+	}
+
+	for _, subgraphs := range s.subgraphs {
+		for _, subgraph := range subgraphs {
+			if subgraph.GetId() == req.Msg.GetId() {
+				return connect.NewResponse(&platformv1.GetSubgraphByIdResponse{Response: ok(), Graph: subgraph}), nil
+			}
+		}
+	}
+
+	return connect.NewResponse(&platformv1.GetSubgraphByIdResponse{Response: notFound()}), nil
+}
+
+func (s *PlatformServer) GetLatestSubgraphSDL(_ context.Context, req *connect.Request[platformv1.GetLatestSubgraphSDLRequest]) (*connect.Response[platformv1.GetLatestSubgraphSDLResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.record("GetLatestSubgraphSDL"); err != nil {
+		return nil, err
+	}
+
+	schema, found := s.schemas[req.Msg.GetNamespace()][req.Msg.GetName()]
+	if !found {
+		return connect.NewResponse(&platformv1.GetLatestSubgraphSDLResponse{Response: notFound()}), nil
+	}
+
+	return connect.NewResponse(&platformv1.GetLatestSubgraphSDLResponse{Response: ok(), Sdl: &schema}), nil
+}
+
+func (s *PlatformServer) PublishFederatedSubgraph(_ context.Context, req *connect.Request[platformv1.PublishFederatedSubgraphRequest]) (*connect.Response[platformv1.PublishFederatedSubgraphResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.record("PublishFederatedSubgraph"); err != nil {
+		return nil, err
+	}
+
+	if _, found := s.subgraphs[req.Msg.GetNamespace()][req.Msg.GetName()]; !found {
+		return connect.NewResponse(&platformv1.PublishFederatedSubgraphResponse{Response: notFound()}), nil
+	}
+
+	hasChanged := s.schemas[req.Msg.GetNamespace()][req.Msg.GetName()] != req.Msg.GetSchema()
+	s.schemas[req.Msg.GetNamespace()][req.Msg.GetName()] = req.Msg.GetSchema()
+
+	return connect.NewResponse(&platformv1.PublishFederatedSubgraphResponse{Response: ok(), HasChanged: &hasChanged}), nil
+}
+
+// PublishMonograph accepts schemas for monographs, which are kept as
+// federated graphs by the fake.
+func (s *PlatformServer) PublishMonograph(_ context.Context, req *connect.Request[platformv1.PublishMonographRequest]) (*connect.Response[platformv1.PublishMonographResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.record("PublishMonograph"); err != nil {
+		return nil, err
+	}
+
+	if _, found := s.graphs[req.Msg.GetNamespace()][req.Msg.GetName()]; !found {
+		return connect.NewResponse(&platformv1.PublishMonographResponse{Response: notFound()}), nil
+	}
+
+	return connect.NewResponse(&platformv1.PublishMonographResponse{Response: ok()}), nil
+}
+
+func (s *PlatformServer) WhoAmI(_ context.Context, _ *connect.Request[platformv1.WhoAmIRequest]) (*connect.Response[platformv1.WhoAmIResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.record("WhoAmI"); err != nil {
+		return nil, err
+	}
+
+	response := &platformv1.WhoAmIResponse{Response: ok()}
+	if s.user != "" {
+		response.UserEmail = &s.user
+	}
+
+	return connect.NewResponse(response), nil
+}
+
+func (s *PlatformServer) GetOrganizationMembers(_ context.Context, req *connect.Request[platformv1.GetOrganizationMembersRequest]) (*connect.Response[platformv1.GetOrganizationMembersResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.record("GetOrganizationMembers"); err != nil {
+		return nil, err
+	}
+
+	members := make([]*platformv1.OrgMember, 0, len(s.members))
+	for email, member := range s.members {
+		if req.Msg.Search == nil || strings.Contains(email, req.Msg.GetSearch()) {
+			members = append(members, member)
+		}
+	}
+
+	return connect.NewResponse(&platformv1.GetOrganizationMembersResponse{Response: ok(), Members: members, TotalCount: int32(len(members))}), nil
+}
+
+func (s *PlatformServer) UpdateOrgMemberRole(_ context.Context, req *connect.Request[platformv1.UpdateOrgMemberRoleRequest]) (*connect.Response[platformv1.UpdateOrgMemberRoleResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.record("UpdateOrgMemberRole"); err != nil {
+		return nil, err
+	}
+
+	for _, member := range s.members {
+		if member.GetUserID() == req.Msg.GetOrgMemberUserID() {
+			member.Roles = []string{req.Msg.GetRole()}
+			return connect.NewResponse(&platformv1.UpdateOrgMemberRoleResponse{Response: ok()}), nil
+		}
+	}
+
+	return connect.NewResponse(&platformv1.UpdateOrgMemberRoleResponse{Response: notFound()}), nil
+}
+
+func (s *PlatformServer) RemoveOrganizationMember(_ context.Context, req *connect.Request[platformv1.RemoveOrganizationMemberRequest]) (*connect.Response[platformv1.RemoveOrganizationMemberResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.record("RemoveOrganizationMember"); err != nil {
+		return nil, err
+	}
+
+	if _, found := s.members[req.Msg.GetEmail()]; !found {
+		return connect.NewResponse(&platformv1.RemoveOrganizationMemberResponse{Response: notFound()}), nil
+	}
+	delete(s.members, req.Msg.GetEmail())
+
+	return connect.NewResponse(&platformv1.RemoveOrganizationMemberResponse{Response: ok()}), nil
+}
+
+func (s *PlatformServer) MigrateFromApollo(_ context.Context, req *connect.Request[platformv1.MigrateFromApolloRequest]) (*connect.Response[platformv1.MigrateFromApolloResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.record("MigrateFromApollo"); err != nil {
+		return nil, err
+	}
+
+	apollo, found := s.apolloGraphs[req.Msg.GetApiKey()]
+	if !found || apollo.variant != req.Msg.GetVariantName() {
+		details := "could not fetch the graph from Apollo"
+		return connect.NewResponse(&platformv1.MigrateFromApolloResponse{Response: &platformv1.Response{Code: common.EnumStatusCode_ERR, Details: &details}}), nil
+	}
+
+	namespace := req.Msg.GetNamespace()
+	if _, exists := s.graphs[namespace][apollo.graph.GetName()]; exists {
+		details := fmt.Sprintf("federated graph '%s' already exists", apollo.graph.GetName())
+		return connect.NewResponse(&platformv1.MigrateFromApolloResponse{Response: &platformv1.Response{Code: common.EnumStatusCode_ERR_ALREADY_EXISTS, Details: &details}}), nil
+	}
+
+	graph := proto.Clone(apollo.graph).(*platformv1.FederatedGraph)
+	graph.Namespace = namespace
+	if s.graphs[namespace] == nil {
+		s.graphs[namespace] = map[string]*platformv1.FederatedGraph{}
+	}
+	s.graphs[namespace][graph.GetName()] = graph
+
+	if s.subgraphs[namespace] == nil {
+		s.subgraphs[namespace] = map[string]*platformv1.Subgraph{}
+		s.schemas[namespace] = map[string]string{}
+	}
+	if s.graphSubgraphs[namespace] == nil {
+		s.graphSubgraphs[namespace] = map[string][]string{}
+	}
+	for _, apolloSubgraph := range apollo.subgraphs {
+		subgraph := proto.Clone(apolloSubgraph).(*platformv1.Subgraph)
+		subgraph.Namespace = namespace
+		s.subgraphs[namespace][subgraph.GetName()] = subgraph
+		s.schemas[namespace][subgraph.GetName()] = ""
+		s.graphSubgraphs[namespace][graph.GetName()] = append(s.graphSubgraphs[namespace][graph.GetName()], subgraph.GetName())
+	}
+
+	token := RouterToken(graph.GetId())
+	if s.opaqueTokens {
+		token = "token-" + graph.GetName()
+	}
+
+	return connect.NewResponse(&platformv1.MigrateFromApolloResponse{Response: ok(), Token: token}), nil
+}
+
+// CreatePlaygroundScript stores the script under the next free id. Like the
+// platform, it does not return the id.
+func (s *PlatformServer) CreatePlaygroundScript(_ context.Context, req *connect.Request[platformv1.CreatePlaygroundScriptRequest]) (*connect.Response[platformv1.CreatePlaygroundScriptResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.record("CreatePlaygroundScript"); err != nil {
+		return nil, err
+	}
+
+	s.scripts = append(s.scripts, &platformv1.PlaygroundScript{
+		Id:      fmt.Sprintf("script-%d", len(s.scripts)+1),
+		Type:    req.Msg.GetType(),
+		Title:   req.Msg.GetTitle(),
+		Content: req.Msg.GetContent(),
+	})
+
+	return connect.NewResponse(&platformv1.CreatePlaygroundScriptResponse{Response: ok()}), nil
+}
+
+func (s *PlatformServer) GetPlaygroundScripts(_ context.Context, req *connect.Request[platformv1.GetPlaygroundScriptsRequest]) (*connect.Response[platformv1.GetPlaygroundScriptsResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.record("GetPlaygroundScripts"); err != nil {
+		return nil, err
+	}
+
+	scripts := []*platformv1.PlaygroundScript{}
+	for _, script := range s.scripts {
+		if script.GetType() == req.Msg.GetType() {
+			scripts = append(scripts, proto.Clone(script).(*platformv1.PlaygroundScript))
+		}
+	}
+
+	return connect.NewResponse(&platformv1.GetPlaygroundScriptsResponse{Response: ok(), Scripts: scripts}), nil
+}
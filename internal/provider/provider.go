@@ -10,16 +10,32 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.opentelemetry.io/otel"
 
 	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+	api_key "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/api-key"
+	apollo_migration "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/apollo-migration"
 	contract "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/contract"
+	event_driven_subgraph "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/event-driven-subgraph"
 	feature_flag "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/feature-flag"
+	feature_flag_state "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/feature-flag-state"
 	feature_subgraph "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/feature-subgraph"
 	federated_graph "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/federated-graph"
 	monograph "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/monograph"
 	namespace "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/namespace"
+	namespace_graph_pruning_config "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/namespace-graph-pruning-config"
+	namespace_lint_config "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/namespace-lint-config"
+	oidc_provider "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/oidc-provider"
+	operation_check_override "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/operation-check-override"
+	organization_invitation "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/organization-invitation"
+	organization_member_role "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/organization-member-role"
+	organization_webhook "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/organization-webhook"
+	persisted_operations "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/persisted-operations"
+	playground_script "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/playground-script"
 	router_token "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/router-token"
 	subgraph "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/subgraph"
+	subgraph_member "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/subgraph-member"
+	subgraph_schema "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/service/subgraph-schema"
 	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
 )
 
@@ -41,8 +57,10 @@ type Provider struct {
 
 // CosmoProviderModel describes the provider data model.
 type CosmoProviderModel struct {
-	ApiUrl types.String `tfsdk:"api_url"`
-	ApiKey types.String `tfsdk:"api_key"`
+	ApiUrl                 types.String `tfsdk:"api_url"`
+	ApiKey                 types.String `tfsdk:"api_key"`
+	DisableReadCache       types.Bool   `tfsdk:"disable_read_cache"`
+	DisableCompositionLock types.Bool   `tfsdk:"disable_composition_lock"`
 }
 
 func (p *CosmoProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -67,6 +85,14 @@ Refer to the official [Cosmo Documentation](https://cosmo-docs.wundergraph.com/)
 				MarkdownDescription: fmt.Sprintf("The Api Key to be used: Leave blank to use the %s environment variable", utils.EnvCosmoApiKey),
 				Optional:            true,
 			},
+			"disable_read_cache": schema.BoolAttribute{
+				MarkdownDescription: "Disables the in-memory cache which loads the graphs and subgraphs of a namespace at once and keeps them, and the schemas read from the Api, until they are changed by the provider. Every read is then sent to the Api individually. The cache is loaded through list endpoints which don't return readmes, so changes to readmes made outside of Terraform are only detected with the cache disabled.",
+				Optional:            true,
+			},
+			"disable_composition_lock": schema.BoolAttribute{
+				MarkdownDescription: "Disables serializing the mutations which trigger a composition, e.g. publishing a subgraph or updating a federated graph, per namespace. Parallel applies then compose concurrently within a namespace.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -83,7 +109,11 @@ func (p *CosmoProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	cosmoApiKey := data.ApiKey.ValueString()
 	cosmoApiUrl := data.ApiUrl.ValueString()
 
-	platformClient, err := api.NewClient(cosmoApiKey, cosmoApiUrl)
+	platformClient, err := api.NewClient(cosmoApiKey, cosmoApiUrl,
+		api.WithReadCache(!data.DisableReadCache.ValueBool()),
+		api.WithCompositionLock(!data.DisableCompositionLock.ValueBool()),
+		api.WithInterceptor(api.TracingInterceptor(otel.GetTracerProvider(), otel.GetTextMapPropagator())),
+	)
 
 	if err != nil {
 		utils.AddDiagnosticError(resp, "Error configuring client", err.Error())
@@ -103,6 +133,21 @@ func (p *CosmoProvider) Resources(ctx context.Context) []func() resource.Resourc
 		monograph.NewMonographResource,
 		router_token.NewTokenResource,
 		contract.NewContractResource,
+		api_key.NewApiKeyResource,
+		organization_webhook.NewOrganizationWebhookResource,
+		namespace_lint_config.NewNamespaceLintConfigResource,
+		namespace_graph_pruning_config.NewNamespaceGraphPruningConfigResource,
+		persisted_operations.NewPersistedOperationsResource,
+		subgraph_member.NewSubgraphMemberResource,
+		organization_invitation.NewOrganizationInvitationResource,
+		organization_member_role.NewOrganizationMemberRoleResource,
+		oidc_provider.NewOIDCProviderResource,
+		playground_script.NewPlaygroundScriptResource,
+		operation_check_override.NewOperationCheckOverrideResource,
+		subgraph_schema.NewSubgraphSchemaResource,
+		feature_flag_state.NewFeatureFlagStateResource,
+		event_driven_subgraph.NewEventDrivenSubgraphResource,
+		apollo_migration.NewApolloMigrationResource,
 	}
 }
 
@@ -115,6 +160,8 @@ func (p *CosmoProvider) DataSources(ctx context.Context) []func() datasource.Dat
 		namespace.NewNamespaceDataSource,
 		monograph.NewMonographDataSource,
 		contract.NewContractDataSource,
+		api_key.NewApiKeyDataSource,
+		subgraph_member.NewSubgraphMembersDataSource,
 	}
 }
 
@@ -0,0 +1,119 @@
+package api_key
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ApiKeyDataSource{}
+
+func NewApiKeyDataSource() datasource.DataSource {
+	return &ApiKeyDataSource{}
+}
+
+// ApiKeyDataSource defines the data source implementation.
+type ApiKeyDataSource struct {
+	client *api.PlatformClient
+}
+
+// ApiKeyDataSourceModel describes the data source data model.
+type ApiKeyDataSourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	CreatedBy  types.String `tfsdk:"created_by"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	LastUsedAt types.String `tfsdk:"last_used_at"`
+	ExpiresAt  types.String `tfsdk:"expires_at"`
+}
+
+func (d *ApiKeyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (d *ApiKeyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Cosmo API Key Data Source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the API key.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the API key.",
+			},
+			"created_by": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The user who created the API key.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The timestamp when the API key was created.",
+			},
+			"last_used_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The timestamp when the API key was last used.",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The timestamp when the API key expires, empty if it never expires.",
+			},
+		},
+	}
+}
+
+func (d *ApiKeyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.PlatformClient)
+	if !ok {
+		utils.AddDiagnosticError(resp, ErrUnexpectedDataSourceType, fmt.Sprintf("Expected *api.PlatformClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ApiKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ApiKeyDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Name.IsNull() || data.Name.ValueString() == "" {
+		utils.AddDiagnosticError(resp, ErrInvalidApiKeyName, "The 'name' attribute is required.")
+		return
+	}
+
+	apiKey, apiError := d.client.GetApiKey(ctx, data.Name.ValueString())
+	if apiError != nil {
+		utils.AddDiagnosticError(resp, ErrReadingApiKey, apiError.Error())
+		return
+	}
+
+	data.Id = types.StringValue(apiKey.Id)
+	data.Name = types.StringValue(apiKey.Name)
+	data.CreatedBy = types.StringValue(apiKey.CreatedBy)
+	data.CreatedAt = types.StringValue(apiKey.CreatedAt)
+	data.LastUsedAt = types.StringValue(apiKey.LastUsedAt)
+	data.ExpiresAt = types.StringValue(apiKey.ExpiresAt)
+
+	tflog.Trace(ctx, "Read API key data source", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
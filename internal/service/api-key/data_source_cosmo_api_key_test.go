@@ -0,0 +1,40 @@
+package api_key_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+func TestAccApiKeyDataSource(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-api-key")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApiKeyDataSourceConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cosmo_api_key.test", "name", name),
+					resource.TestCheckResourceAttrPair("data.cosmo_api_key.test", "id", "cosmo_api_key.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccApiKeyDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "cosmo_api_key" "test" {
+  name = "%s"
+}
+
+data "cosmo_api_key" "test" {
+  name = cosmo_api_key.test.name
+}
+`, name)
+}
@@ -0,0 +1,10 @@
+package api_key
+
+const (
+	ErrCreatingApiKey           = "Error Creating API Key"
+	ErrReadingApiKey            = "Error Reading API Key"
+	ErrUpdatingApiKey           = "Error Updating API Key"
+	ErrDeletingApiKey           = "Error Deleting API Key"
+	ErrInvalidApiKeyName        = "Invalid API Key Name"
+	ErrUnexpectedDataSourceType = "Unexpected Data Source Configure Type"
+)
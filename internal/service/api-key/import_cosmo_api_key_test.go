@@ -0,0 +1,31 @@
+package api_key_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+func TestAccCosmoApiKeyImportBasic(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-api-key")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApiKeyResourceConfig(name, "1_year"),
+			},
+			{
+				// import via name, the secret value is only known on creation
+				ResourceName:            "cosmo_api_key.test",
+				ImportState:             true,
+				ImportStateId:           name,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key"},
+			},
+		},
+	})
+}
@@ -0,0 +1,304 @@
+package api_key
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
+)
+
+var (
+	_ resource.Resource                = (*ApiKeyResource)(nil)
+	_ resource.ResourceWithImportState = (*ApiKeyResource)(nil)
+)
+
+type ApiKeyResource struct {
+	client *api.PlatformClient
+}
+
+type ApiKeyResourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Expires           types.String `tfsdk:"expires"`
+	Permissions       types.Set    `tfsdk:"permissions"`
+	FederatedGraphIds types.Set    `tfsdk:"federated_graph_ids"`
+	SubgraphIds       types.Set    `tfsdk:"subgraph_ids"`
+	Key               types.String `tfsdk:"key"`
+	CreatedBy         types.String `tfsdk:"created_by"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+	ExpiresAt         types.String `tfsdk:"expires_at"`
+}
+
+func NewApiKeyResource() resource.Resource {
+	return &ApiKeyResource{}
+}
+
+func (r *ApiKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (r *ApiKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Creates an API key for the organization, e.g. for CI pipelines running wgc. The secret value of the key is only returned when the key is created.
+
+API keys cannot be changed after creation, any change of the configuration recreates the key. The permissions and resource restrictions of a key are not returned by the platform, therefore changes made to them outside of Terraform are not detected.
+
+For more information on API keys, please refer to the [Cosmo Documentation](https://cosmo-docs.wundergraph.com/studio/api-keys).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the API key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the API key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expires": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(api.ApiKeyExpiresNever),
+				MarkdownDescription: fmt.Sprintf("When the API key expires after its creation. One of %s, %s, %s or %s.", api.ApiKeyExpiresNever, api.ApiKeyExpiresThirtyDays, api.ApiKeyExpiresSixMonths, api.ApiKeyExpiresOneYear),
+				Validators: []validator.String{
+					stringvalidator.OneOf(api.ApiKeyExpiresNever, api.ApiKeyExpiresThirtyDays, api.ApiKeyExpiresSixMonths, api.ApiKeyExpiresOneYear),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permissions": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Additional permissions granted to the API key, e.g. `scim`.",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"federated_graph_ids": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Restricts the API key to the federated graphs and monographs with these ids. Without any federated graph or subgraph ids the key has access to all resources.",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"subgraph_ids": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Restricts the API key to the subgraphs with these ids. Without any federated graph or subgraph ids the key has access to all resources.",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The secret value of the API key. Only known for keys created by Terraform, it is empty for imported keys.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The user who created the API key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The timestamp when the API key was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The timestamp when the API key expires, empty if it never expires.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ApiKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.PlatformClient)
+	if !ok {
+		utils.AddDiagnosticError(resp, ErrUnexpectedDataSourceType, fmt.Sprintf("Expected *api.PlatformClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ApiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ApiKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var permissions, federatedGraphIds, subgraphIds []string
+	resp.Diagnostics.Append(data.Permissions.ElementsAs(ctx, &permissions, false)...)
+	resp.Diagnostics.Append(data.FederatedGraphIds.ElementsAs(ctx, &federatedGraphIds, false)...)
+	resp.Diagnostics.Append(data.SubgraphIds.ElementsAs(ctx, &subgraphIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, apiError := r.client.CreateApiKey(ctx, &platformv1.CreateAPIKeyRequest{
+		Name:                    data.Name.ValueString(),
+		Expires:                 api.ResolveApiKeyExpiration(data.Expires.ValueString()),
+		Permissions:             permissions,
+		FederatedGraphTargetIds: federatedGraphIds,
+		SubgraphTargetIds:       subgraphIds,
+		AllowAllResources:       len(federatedGraphIds) == 0 && len(subgraphIds) == 0,
+	})
+	if apiError != nil {
+		utils.AddDiagnosticError(resp, ErrCreatingApiKey, apiError.Error())
+		return
+	}
+
+	apiKey, apiError := r.client.GetApiKey(ctx, data.Name.ValueString())
+	if apiError != nil {
+		utils.AddDiagnosticError(resp, ErrReadingApiKey, apiError.Error())
+		return
+	}
+
+	data.Key = types.StringValue(key)
+	mapApiKeyToResourceModel(apiKey, &data)
+
+	utils.LogAction(ctx, "created", data.Id.ValueString(), data.Name.ValueString(), "")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ApiKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiKey, apiError := r.client.GetApiKey(ctx, data.Name.ValueString())
+	if apiError != nil {
+		if api.IsNotFoundError(apiError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddDiagnosticError(resp, ErrReadingApiKey, apiError.Error())
+		return
+	}
+
+	mapApiKeyToResourceModel(apiKey, &data)
+
+	// Imported keys have no expiration set, derive it from the timestamps
+	// to avoid planning a replacement.
+	if data.Expires.IsNull() {
+		data.Expires = types.StringValue(expiresFromTimestamps(apiKey.CreatedAt, apiKey.ExpiresAt))
+	}
+
+	utils.LogAction(ctx, "read", data.Id.ValueString(), data.Name.ValueString(), "")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApiKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	utils.AddDiagnosticError(resp, ErrUpdatingApiKey, "API key update should never be called, please delete and recreate the API key")
+}
+
+func (r *ApiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ApiKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiError := r.client.DeleteApiKey(ctx, data.Name.ValueString())
+	if apiError != nil {
+		if api.IsNotFoundError(apiError) {
+			return
+		}
+		utils.AddDiagnosticError(resp, ErrDeletingApiKey, apiError.Error())
+		return
+	}
+
+	utils.LogAction(ctx, "deleted", data.Id.ValueString(), data.Name.ValueString(), "")
+}
+
+func (r *ApiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		utils.AddDiagnosticError(resp, ErrInvalidApiKeyName, "The API key must be imported by its name.")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+}
+
+func mapApiKeyToResourceModel(apiKey *platformv1.APIKey, data *ApiKeyResourceModel) {
+	data.Id = types.StringValue(apiKey.Id)
+	data.Name = types.StringValue(apiKey.Name)
+	data.CreatedBy = types.StringValue(apiKey.CreatedBy)
+	data.CreatedAt = types.StringValue(apiKey.CreatedAt)
+	data.ExpiresAt = types.StringValue(apiKey.ExpiresAt)
+
+	if data.Key.IsNull() || data.Key.IsUnknown() {
+		data.Key = types.StringValue("")
+	}
+}
+
+// expiresFromTimestamps maps the lifetime of a key back to the closest
+// expiration option offered by the platform.
+func expiresFromTimestamps(createdAt, expiresAt string) string {
+	if expiresAt == "" {
+		return api.ApiKeyExpiresNever
+	}
+
+	created, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		return api.ApiKeyExpiresNever
+	}
+	expires, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return api.ApiKeyExpiresNever
+	}
+
+	days := expires.Sub(created).Hours() / 24
+	switch {
+	case days <= 90:
+		return api.ApiKeyExpiresThirtyDays
+	case days <= 270:
+		return api.ApiKeyExpiresSixMonths
+	default:
+		return api.ApiKeyExpiresOneYear
+	}
+}
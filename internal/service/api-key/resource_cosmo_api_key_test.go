@@ -0,0 +1,101 @@
+package api_key_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+func TestAccApiKeyResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-api-key")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApiKeyResourceConfig(name, "30_days"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_api_key.test", "name", name),
+					resource.TestCheckResourceAttr("cosmo_api_key.test", "expires", "30_days"),
+					resource.TestCheckResourceAttrSet("cosmo_api_key.test", "id"),
+					resource.TestCheckResourceAttrSet("cosmo_api_key.test", "key"),
+					resource.TestCheckResourceAttrSet("cosmo_api_key.test", "expires_at"),
+				),
+			},
+			{
+				ResourceName: "cosmo_api_key.test",
+				RefreshState: true,
+			},
+			{
+				Config: testAccApiKeyResourceConfig(name, "never"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("cosmo_api_key.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_api_key.test", "expires", "never"),
+					resource.TestCheckResourceAttr("cosmo_api_key.test", "expires_at", ""),
+				),
+			},
+			{
+				Config:  testAccApiKeyResourceConfig(name, "never"),
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func TestAccApiKeyResourceRestrictedToFederatedGraph(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-api-key")
+	namespace := acctest.RandomWithPrefix("test-namespace")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApiKeyResourceRestrictedConfig(namespace, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_api_key.test", "name", name),
+					resource.TestCheckResourceAttr("cosmo_api_key.test", "federated_graph_ids.#", "1"),
+					resource.TestCheckResourceAttrSet("cosmo_api_key.test", "key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccApiKeyResourceConfig(name, expires string) string {
+	return fmt.Sprintf(`
+resource "cosmo_api_key" "test" {
+  name    = "%s"
+  expires = "%s"
+}
+`, name, expires)
+}
+
+func testAccApiKeyResourceRestrictedConfig(namespace, name string) string {
+	return fmt.Sprintf(`
+resource "cosmo_namespace" "test" {
+  name = "%s"
+}
+
+resource "cosmo_federated_graph" "test" {
+  name        = "federated-graph"
+  namespace   = cosmo_namespace.test.name
+  routing_url = "https://example.com"
+}
+
+resource "cosmo_api_key" "test" {
+  name                = "%s"
+  federated_graph_ids = [cosmo_federated_graph.test.id]
+}
+`, namespace, name)
+}
@@ -0,0 +1,9 @@
+package apollo_migration
+
+const (
+	ErrMigratingFromApollo      = "Error Migrating From Apollo"
+	ErrIdentifyingMigratedGraph = "Error Identifying Migrated Graph"
+	ErrReadingMigration         = "Error Reading Apollo Migration"
+	ErrUpdatingMigration        = "Error Updating Apollo Migration"
+	ErrUnexpectedDataSourceType = "Unexpected Data Source Configure Type"
+)
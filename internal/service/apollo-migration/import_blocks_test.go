@@ -0,0 +1,34 @@
+package apollo_migration
+
+import (
+	"testing"
+
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+)
+
+func TestImportBlocks(t *testing.T) {
+	graph := &platformv1.FederatedGraph{Id: "graph-id", Name: "wg.orders"}
+	subgraphs := []*platformv1.Subgraph{
+		{Id: "employees-id", Name: "employees"},
+		{Id: "products-id", Name: "1-products"},
+	}
+
+	want := `import {
+  to = cosmo_federated_graph.wg_orders
+  id = "graph-id"
+}
+
+import {
+  to = cosmo_subgraph.employees
+  id = "employees-id"
+}
+
+import {
+  to = cosmo_subgraph._1-products
+  id = "products-id"
+}
+`
+	if got := importBlocks(graph, subgraphs); got != want {
+		t.Errorf("importBlocks() = %q, want %q", got, want)
+	}
+}
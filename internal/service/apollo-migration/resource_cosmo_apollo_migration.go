@@ -0,0 +1,284 @@
+package apollo_migration
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
+)
+
+// defaultVariant is the variant Apollo uses for graph refs without one.
+const defaultVariant = "current"
+
+var (
+	graphRefRegex = regexp.MustCompile(`^[^@\s]+(@[^@\s]+)?$`)
+	// invalidIdentifierRegex matches the characters which are not allowed in
+	// Terraform resource names.
+	invalidIdentifierRegex = regexp.MustCompile(`[^A-Za-z0-9_-]`)
+)
+
+var _ resource.Resource = (*ApolloMigrationResource)(nil)
+
+type ApolloMigrationResource struct {
+	client *api.PlatformClient
+}
+
+type ApolloMigrationResourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	ApiKey             types.String `tfsdk:"api_key"`
+	GraphRef           types.String `tfsdk:"graph_ref"`
+	Namespace          types.String `tfsdk:"namespace"`
+	FederatedGraphName types.String `tfsdk:"federated_graph_name"`
+	SubgraphNames      types.List   `tfsdk:"subgraph_names"`
+	RouterToken        types.String `tfsdk:"router_token"`
+	ImportBlocks       types.String `tfsdk:"import_blocks"`
+}
+
+func NewApolloMigrationResource() resource.Resource {
+	return &ApolloMigrationResource{}
+}
+
+func (r *ApolloMigrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_apollo_migration"
+}
+
+func (r *ApolloMigrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Migrates a federated graph and its subgraphs from Apollo GraphOS into a namespace of Cosmo. The migration runs once when the resource is created.
+
+The migrated graphs are meant to be managed with ` + "`cosmo_federated_graph`" + ` and ` + "`cosmo_subgraph`" + ` afterwards, ` + "`import_blocks`" + ` holds the ` + "`import`" + ` blocks to bring them under management. Destroying the resource only removes it from the state, the migrated graphs are kept.
+
+For more information on migrating from Apollo, please refer to the [Cosmo Documentation](https://cosmo-docs.wundergraph.com/studio/migrate-from-apollo).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the migrated federated graph. It is null, as are the other attributes of the graph, if the migrated graph could not be identified.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"api_key": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The Apollo GraphOS API key of the graph to migrate.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"graph_ref": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: fmt.Sprintf("The graph ref of the Apollo graph to migrate, e.g. `my-graph@production`. The `%s` variant is migrated if the graph ref has no variant.", defaultVariant),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(graphRefRegex, "must be a graph ref of the form <graph id>@<variant>"),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("default"),
+				MarkdownDescription: "The namespace to migrate the graph into.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"federated_graph_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the migrated federated graph.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subgraph_names": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The names of the migrated subgraphs.",
+			},
+			"router_token": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The router token of the migrated federated graph.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"import_blocks": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The `import` blocks of the migrated federated graph and subgraphs, ready to be pasted into the configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ApolloMigrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.PlatformClient)
+	if !ok {
+		utils.AddDiagnosticError(resp, ErrUnexpectedDataSourceType, fmt.Sprintf("Expected *api.PlatformClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ApolloMigrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ApolloMigrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	graphId, variant, found := strings.Cut(data.GraphRef.ValueString(), "@")
+	if !found {
+		variant = defaultVariant
+	}
+
+	migration, apiError := r.client.MigrateFromApollo(ctx, data.ApiKey.ValueString(), graphId, variant, data.Namespace.ValueString())
+	if migration == nil {
+		utils.AddDiagnosticError(resp, ErrMigratingFromApollo, apiError.Error())
+		return
+	}
+
+	data.RouterToken = types.StringValue(migration.Token)
+
+	// The migration cannot be run again, so it is kept in the state with the
+	// router token even if the migrated graph is unknown.
+	if migration.FederatedGraph == nil {
+		message := "The graph was migrated, but the migrated federated graph could not be identified. Import it with cosmo_federated_graph to manage it."
+		if apiError != nil {
+			message += " " + apiError.Error()
+		}
+		utils.AddDiagnosticWarning(resp, ErrIdentifyingMigratedGraph, message)
+
+		data.Id = types.StringNull()
+		data.FederatedGraphName = types.StringNull()
+		data.SubgraphNames = types.ListNull(types.StringType)
+		data.ImportBlocks = types.StringNull()
+
+		utils.LogAction(ctx, "created", "", graphId, data.Namespace.ValueString())
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	subgraphs := slices.Clone(migration.Subgraphs)
+	slices.SortFunc(subgraphs, func(a, b *platformv1.Subgraph) int {
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+
+	subgraphNames := make([]string, 0, len(subgraphs))
+	for _, subgraph := range subgraphs {
+		subgraphNames = append(subgraphNames, subgraph.GetName())
+	}
+
+	subgraphNamesValue, diags := types.ListValueFrom(ctx, types.StringType, subgraphNames)
+	resp.Diagnostics.Append(diags...)
+
+	data.Id = types.StringValue(migration.FederatedGraph.GetId())
+	data.FederatedGraphName = types.StringValue(migration.FederatedGraph.GetName())
+	data.SubgraphNames = subgraphNamesValue
+	data.ImportBlocks = types.StringValue(importBlocks(migration.FederatedGraph, subgraphs))
+
+	utils.LogAction(ctx, "created", data.Id.ValueString(), data.FederatedGraphName.ValueString(), data.Namespace.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApolloMigrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ApolloMigrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The migration itself cannot be read, it is kept as long as the
+	// migrated federated graph exists, or for good if it is unknown.
+	if data.Id.IsNull() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	_, apiError := r.client.GetFederatedGraphById(ctx, data.Id.ValueString())
+	if apiError != nil {
+		if api.IsNotFoundError(apiError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddDiagnosticError(resp, ErrReadingMigration, apiError.Error())
+		return
+	}
+
+	utils.LogAction(ctx, "read", data.Id.ValueString(), data.FederatedGraphName.ValueString(), data.Namespace.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApolloMigrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	utils.AddDiagnosticError(resp, ErrUpdatingMigration, "Apollo migration update should never be called, please delete and recreate the migration")
+}
+
+func (r *ApolloMigrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ApolloMigrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The migrated graphs are kept, they are managed by their own resources.
+	utils.LogAction(ctx, "deleted", data.Id.ValueString(), data.FederatedGraphName.ValueString(), data.Namespace.ValueString())
+}
+
+// importBlocks renders the import blocks of the migrated federated graph and
+// subgraphs, which are both imported by their id.
+func importBlocks(graph *platformv1.FederatedGraph, subgraphs []*platformv1.Subgraph) string {
+	var b strings.Builder
+	writeImportBlock(&b, "cosmo_federated_graph", graph.GetName(), graph.GetId())
+	for _, subgraph := range subgraphs {
+		b.WriteString("\n")
+		writeImportBlock(&b, "cosmo_subgraph", subgraph.GetName(), subgraph.GetId())
+	}
+	return b.String()
+}
+
+func writeImportBlock(b *strings.Builder, resourceType, name, id string) {
+	fmt.Fprintf(b, "import {\n  to = %s.%s\n  id = %q\n}\n", resourceType, resourceName(name), id)
+}
+
+// resourceName turns the name of a graph into a valid Terraform resource
+// name, e.g. "wg.orders" into "wg_orders".
+func resourceName(name string) string {
+	name = invalidIdentifierRegex.ReplaceAllString(name, "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') || name[0] == '-' {
+		name = "_" + name
+	}
+	return name
+}
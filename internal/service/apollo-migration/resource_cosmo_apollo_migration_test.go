@@ -0,0 +1,100 @@
+package apollo_migration_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/fake"
+)
+
+// The migration needs a graph on Apollo GraphOS, so the tests run against the
+// fake platform instead of a real control plane.
+func TestAccApolloMigrationResource(t *testing.T) {
+	server := fake.NewPlatformServer()
+	server.AddApolloGraph("apollo-key", "production",
+		&platformv1.FederatedGraph{Name: "wg.orders", RoutingURL: "http://localhost:3000/graphql"},
+		&platformv1.Subgraph{Name: "products", RoutingURL: "http://localhost:4002/graphql"},
+		&platformv1.Subgraph{Name: "employees", RoutingURL: "http://localhost:4001/graphql"},
+	)
+	apiUrl := server.Start(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApolloMigrationResourceConfig(apiUrl, "my-graph@production"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("cosmo_apollo_migration.test", "id"),
+					resource.TestCheckResourceAttr("cosmo_apollo_migration.test", "namespace", "default"),
+					resource.TestCheckResourceAttr("cosmo_apollo_migration.test", "federated_graph_name", "wg.orders"),
+					resource.TestCheckResourceAttr("cosmo_apollo_migration.test", "subgraph_names.#", "2"),
+					resource.TestCheckResourceAttr("cosmo_apollo_migration.test", "subgraph_names.0", "employees"),
+					resource.TestCheckResourceAttr("cosmo_apollo_migration.test", "subgraph_names.1", "products"),
+					resource.TestCheckResourceAttr("cosmo_apollo_migration.test", "router_token", "token-wg.orders"),
+					resource.TestMatchResourceAttr("cosmo_apollo_migration.test", "import_blocks", regexp.MustCompile(`to = cosmo_federated_graph\.wg_orders`)),
+					resource.TestMatchResourceAttr("cosmo_apollo_migration.test", "import_blocks", regexp.MustCompile(`to = cosmo_subgraph\.employees`)),
+				),
+			},
+			{
+				ResourceName: "cosmo_apollo_migration.test",
+				RefreshState: true,
+			},
+			{
+				Config:  testAccApolloMigrationResourceConfig(apiUrl, "my-graph@production"),
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func TestAccApolloMigrationResourceInvalidVariant(t *testing.T) {
+	server := fake.NewPlatformServer()
+	server.AddApolloGraph("apollo-key", "production",
+		&platformv1.FederatedGraph{Name: "wg.orders", RoutingURL: "http://localhost:3000/graphql"},
+	)
+	apiUrl := server.Start(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccApolloMigrationResourceConfig(apiUrl, "my-graph"),
+				ExpectError: regexp.MustCompile(`Error Migrating From Apollo`),
+			},
+		},
+	})
+}
+
+func TestAccApolloMigrationResourceInvalidGraphRef(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccApolloMigrationResourceConfig("http://localhost", "my-graph@production@staging"),
+				ExpectError: regexp.MustCompile(`must be a graph ref of the form`),
+			},
+		},
+	})
+}
+
+func testAccApolloMigrationResourceConfig(apiUrl, graphRef string) string {
+	return fmt.Sprintf(`
+provider "cosmo" {
+  api_url = "%s"
+  api_key = "cosmo-key"
+}
+
+resource "cosmo_apollo_migration" "test" {
+  api_key   = "apollo-key"
+  graph_ref = "%s"
+}
+`, apiUrl, graphRef)
+}
@@ -0,0 +1,557 @@
+package event_driven_subgraph
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	brokerKafka = "kafka"
+	brokerNats  = "nats"
+	brokerRedis = "redis"
+
+	edfsDirectivePrefix = "edfs__"
+	providerIdArgument  = "providerId"
+)
+
+// edfsDirective describes a directive of Event-Driven Federated
+// Subscriptions (EDFS), see
+// https://cosmo-docs.wundergraph.com/router/event-driven-federated-subscriptions-edfs.
+type edfsDirective struct {
+	broker string
+	// argument is the name of the argument holding the topics, subjects or
+	// channels of the directive.
+	argument string
+	list     bool
+	// operation is the root operation the directive may be used on, e.g.
+	// "subscription".
+	operation string
+}
+
+var edfsDirectives = map[string]edfsDirective{
+	"edfs__kafkaPublish":   {broker: brokerKafka, argument: "topic", operation: operationMutation},
+	"edfs__kafkaSubscribe": {broker: brokerKafka, argument: "topics", list: true, operation: operationSubscription},
+	"edfs__natsPublish":    {broker: brokerNats, argument: "subject", operation: operationMutation},
+	"edfs__natsRequest":    {broker: brokerNats, argument: "subject", operation: operationQuery},
+	"edfs__natsSubscribe":  {broker: brokerNats, argument: "subjects", list: true, operation: operationSubscription},
+	"edfs__redisPublish":   {broker: brokerRedis, argument: "channel", operation: operationMutation},
+	"edfs__redisSubscribe": {broker: brokerRedis, argument: "channels", list: true, operation: operationSubscription},
+}
+
+const (
+	operationQuery        = "query"
+	operationMutation     = "mutation"
+	operationSubscription = "subscription"
+)
+
+// edfsSchema holds the topics, subjects and channels declared by the EDFS
+// directives of a schema, sorted and without duplicates.
+type edfsSchema struct {
+	KafkaTopics   []string
+	NatsSubjects  []string
+	RedisChannels []string
+}
+
+// parseEDFSSchema validates the EDFS directives used in the given SDL and
+// collects the Kafka topics, NATS subjects and Redis channels they declare.
+// All problems found are reported together.
+func parseEDFSSchema(sdl string) (*edfsSchema, error) {
+	tokens, err := lex(sdl)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &sdlParser{tokens: tokens, rootTypes: rootTypes(tokens)}
+	p.parse()
+
+	if p.directives == 0 && len(p.errs) == 0 {
+		p.errs = append(p.errs, errors.New("the schema does not use any EDFS directive, e.g. @edfs__kafkaSubscribe or @edfs__natsSubscribe"))
+	}
+	if len(p.errs) > 0 {
+		return nil, errors.Join(p.errs...)
+	}
+
+	return &edfsSchema{
+		KafkaTopics:   sortedUnique(p.topics[brokerKafka]),
+		NatsSubjects:  sortedUnique(p.topics[brokerNats]),
+		RedisChannels: sortedUnique(p.topics[brokerRedis]),
+	}, nil
+}
+
+// rootTypes returns the names of the root operation types by operation,
+// which are Query, Mutation and Subscription unless a schema definition or
+// extension names other types, e.g. "schema { subscription: Events }".
+func rootTypes(tokens []token) map[string]string {
+	roots := map[string]string{
+		operationQuery:        "Query",
+		operationMutation:     "Mutation",
+		operationSubscription: "Subscription",
+	}
+
+	depth := 0
+	for i := 0; i < len(tokens); i++ {
+		t := tokens[i]
+		if t.kind == tokenPunctuator {
+			switch t.value {
+			case "(", "[", "{":
+				depth++
+			case ")", "]", "}":
+				depth--
+			}
+			continue
+		}
+		if depth != 0 || t.kind != tokenName || t.value != "schema" || !isSchemaKeyword(tokens, i) {
+			continue
+		}
+
+		// Skip the directives of the schema up to its body.
+		nesting := 0
+		for i++; i < len(tokens); i++ {
+			if tokens[i].kind != tokenPunctuator {
+				continue
+			}
+			if nesting == 0 && tokens[i].value == "{" {
+				break
+			}
+			switch tokens[i].value {
+			case "(", "[":
+				nesting++
+			case ")", "]":
+				nesting--
+			}
+		}
+
+		// Read "operation: Type" pairs up to the end of the body.
+		for i++; i+2 < len(tokens) && !(tokens[i].kind == tokenPunctuator && tokens[i].value == "}"); i++ {
+			operation, colon, name := tokens[i], tokens[i+1], tokens[i+2]
+			if operation.kind == tokenName && colon.kind == tokenPunctuator && colon.value == ":" && name.kind == tokenName {
+				if _, ok := roots[operation.value]; ok {
+					roots[operation.value] = name.value
+				}
+				i += 2
+			}
+		}
+	}
+
+	return roots
+}
+
+// isSchemaKeyword tells the schema keyword at the position apart from types
+// or directives named "schema".
+func isSchemaKeyword(tokens []token, i int) bool {
+	if i == 0 {
+		return true
+	}
+	previous := tokens[i-1]
+	if previous.kind != tokenName || previous.value == "extend" {
+		return previous.kind != tokenPunctuator || previous.value != "@"
+	}
+	// A directive without arguments, e.g. "scalar Date @custom schema { ... }".
+	return i >= 2 && tokens[i-2].kind == tokenPunctuator && tokens[i-2].value == "@"
+}
+
+func sortedUnique(values []string) []string {
+	values = slices.Clone(values)
+	slices.Sort(values)
+	return slices.Compact(values)
+}
+
+type tokenKind int
+
+const (
+	tokenName tokenKind = iota
+	tokenString
+	tokenNumber
+	tokenPunctuator
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+	line  int
+}
+
+// lex splits the SDL into tokens, dropping whitespace, commas and comments.
+func lex(sdl string) ([]token, error) {
+	var tokens []token
+	line := 1
+
+	for i := 0; i < len(sdl); {
+		c := sdl[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == ' ' || c == '\t' || c == '\r' || c == ',':
+			i++
+		case c == '#':
+			for i < len(sdl) && sdl[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(sdl[i:], `"""`):
+			end := strings.Index(sdl[i+3:], `"""`)
+			for end >= 0 && sdl[i+3+end-1] == '\\' {
+				next := strings.Index(sdl[i+3+end+3:], `"""`)
+				if next < 0 {
+					end = -1
+					break
+				}
+				end += 3 + next
+			}
+			if end < 0 {
+				return nil, fmt.Errorf("line %d: unterminated block string", line)
+			}
+			value := sdl[i+3 : i+3+end]
+			tokens = append(tokens, token{kind: tokenString, value: value, line: line})
+			line += strings.Count(value, "\n")
+			i += 3 + end + 3
+		case c == '"':
+			end := i + 1
+			for end < len(sdl) && sdl[end] != '"' && sdl[end] != '\n' {
+				if sdl[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(sdl) || sdl[end] != '"' {
+				return nil, fmt.Errorf("line %d: unterminated string", line)
+			}
+			var value string
+			if err := json.Unmarshal([]byte(sdl[i:end+1]), &value); err != nil {
+				return nil, fmt.Errorf("line %d: invalid string %s", line, sdl[i:end+1])
+			}
+			tokens = append(tokens, token{kind: tokenString, value: value, line: line})
+			i = end + 1
+		case strings.HasPrefix(sdl[i:], "..."):
+			tokens = append(tokens, token{kind: tokenPunctuator, value: "...", line: line})
+			i += 3
+		case strings.IndexByte("!$&()/:=@[]{|}", c) >= 0:
+			tokens = append(tokens, token{kind: tokenPunctuator, value: string(c), line: line})
+			i++
+		case c == '_' || isLetter(c):
+			end := i + 1
+			for end < len(sdl) && (sdl[end] == '_' || isLetter(sdl[end]) || isDigit(sdl[end])) {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenName, value: sdl[i:end], line: line})
+			i = end
+		case c == '-' || isDigit(c):
+			end := i + 1
+			for end < len(sdl) && (isDigit(sdl[end]) || strings.IndexByte(".eE+-", sdl[end]) >= 0) {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, value: sdl[i:end], line: line})
+			i = end
+		default:
+			return nil, fmt.Errorf("line %d: unexpected character %q", line, c)
+		}
+	}
+
+	return tokens, nil
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+type valueKind int
+
+const (
+	valueString valueKind = iota
+	valueList
+	valueObject
+	valueOther
+)
+
+type value struct {
+	kind   valueKind
+	str    string
+	list   []value
+	fields map[string]value
+}
+
+// sdlParser walks the tokens of a schema. It does not validate the schema
+// as a whole, which is left to the composition of the platform, it only
+// tracks enough context to validate the usages of EDFS directives.
+type sdlParser struct {
+	tokens    []token
+	pos       int
+	rootTypes map[string]string
+
+	depth        int
+	pendingType  string
+	currentType  string
+	currentField string
+
+	directives int
+	topics     map[string][]string
+	errs       []error
+}
+
+func (p *sdlParser) eof() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *sdlParser) peek() token {
+	if p.eof() {
+		return token{kind: tokenPunctuator}
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *sdlParser) next() token {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *sdlParser) peekIs(value string) bool {
+	t := p.peek()
+	return !p.eof() && t.kind == tokenPunctuator && t.value == value
+}
+
+func (p *sdlParser) errorf(line int, format string, args ...any) {
+	p.errs = append(p.errs, fmt.Errorf("line %d: %s", line, fmt.Sprintf(format, args...)))
+}
+
+func (p *sdlParser) parse() {
+	p.topics = map[string][]string{}
+
+	for !p.eof() {
+		t := p.next()
+
+		switch {
+		case t.kind == tokenName && p.depth == 0:
+			switch t.value {
+			case "directive":
+				p.skipDirectiveDefinition()
+			case "type", "interface":
+				if p.peek().kind == tokenName {
+					p.pendingType = p.next().value
+				}
+			case "input", "enum", "union", "scalar", "schema":
+				p.pendingType = ""
+			}
+		case t.kind == tokenName && p.depth == 1 && p.currentType != "":
+			if p.peekIs("(") {
+				p.currentField = t.value
+				p.skipBalanced()
+			} else if p.peekIs(":") {
+				p.currentField = t.value
+			}
+		case t.kind == tokenPunctuator && t.value == "{":
+			if p.depth == 0 {
+				p.currentType = p.pendingType
+				p.pendingType = ""
+				p.currentField = ""
+			}
+			p.depth++
+		case t.kind == tokenPunctuator && t.value == "}":
+			p.depth--
+			if p.depth == 0 {
+				p.currentType = ""
+				p.currentField = ""
+			}
+		case t.kind == tokenPunctuator && t.value == "@":
+			p.directive(t.line)
+		}
+	}
+}
+
+// skipDirectiveDefinition skips "@name(arguments) repeatable on LOCATIONS".
+func (p *sdlParser) skipDirectiveDefinition() {
+	if p.peekIs("@") {
+		p.next()
+	}
+	p.next()
+	if p.peekIs("(") {
+		p.skipBalanced()
+	}
+	if t := p.peek(); t.kind == tokenName && t.value == "repeatable" {
+		p.next()
+	}
+	if t := p.peek(); t.kind == tokenName && t.value == "on" {
+		p.next()
+	}
+	if p.peekIs("|") {
+		p.next()
+	}
+	p.next()
+	for p.peekIs("|") {
+		p.next()
+		p.next()
+	}
+}
+
+// skipBalanced skips the tokens from the opening parenthesis, bracket or
+// brace at the current position up to and including its counterpart.
+func (p *sdlParser) skipBalanced() {
+	nesting := 0
+	for !p.eof() {
+		t := p.next()
+		if t.kind != tokenPunctuator {
+			continue
+		}
+		switch t.value {
+		case "(", "[", "{":
+			nesting++
+		case ")", "]", "}":
+			nesting--
+		}
+		if nesting == 0 {
+			return
+		}
+	}
+}
+
+// directive handles the usage of a directive, the "@" was already consumed.
+func (p *sdlParser) directive(line int) {
+	t := p.next()
+	if t.kind != tokenName || !strings.HasPrefix(t.value, edfsDirectivePrefix) {
+		if p.peekIs("(") {
+			p.skipBalanced()
+		}
+		return
+	}
+
+	name := t.value
+	arguments := map[string]value{}
+	if p.peekIs("(") {
+		arguments = p.arguments()
+	}
+
+	definition, ok := edfsDirectives[name]
+	if !ok {
+		p.errorf(line, "unknown EDFS directive @%s", name)
+		return
+	}
+	p.directives++
+
+	rootType := p.rootTypes[definition.operation]
+	if p.depth != 1 || p.currentType == "" || p.currentField == "" {
+		p.errorf(line, "@%s can only be used on fields of the %s type", name, rootType)
+		return
+	}
+	if p.currentType != rootType {
+		p.errorf(line, "@%s on %s.%s can only be used on fields of the %s type", name, p.currentType, p.currentField, rootType)
+		return
+	}
+
+	for argument := range arguments {
+		if argument != definition.argument && argument != providerIdArgument && !(name == "edfs__natsSubscribe" && argument == "streamConfiguration") {
+			p.errorf(line, "@%s has no argument %q", name, argument)
+		}
+	}
+
+	if providerId, ok := arguments[providerIdArgument]; ok && (providerId.kind != valueString || providerId.str == "") {
+		p.errorf(line, "the %q argument of @%s must be a non-empty string", providerIdArgument, name)
+	}
+
+	if streamConfiguration, ok := arguments["streamConfiguration"]; ok {
+		if streamConfiguration.kind != valueObject {
+			p.errorf(line, "the \"streamConfiguration\" argument of @%s must be an object", name)
+		} else {
+			for _, field := range []string{"consumerName", "streamName"} {
+				if v := streamConfiguration.fields[field]; v.kind != valueString || v.str == "" {
+					p.errorf(line, "the \"streamConfiguration\" argument of @%s requires a non-empty %q", name, field)
+				}
+			}
+		}
+	}
+
+	argument, ok := arguments[definition.argument]
+	if !ok {
+		p.errorf(line, "@%s requires the %q argument", name, definition.argument)
+		return
+	}
+
+	var topics []value
+	switch {
+	case argument.kind == valueString:
+		// A single value is coerced to a list for list arguments.
+		topics = []value{argument}
+	case argument.kind == valueList && definition.list:
+		topics = argument.list
+	}
+	if len(topics) == 0 {
+		if definition.list {
+			p.errorf(line, "the %q argument of @%s must be a non-empty list of strings", definition.argument, name)
+		} else {
+			p.errorf(line, "the %q argument of @%s must be a non-empty string", definition.argument, name)
+		}
+		return
+	}
+
+	for _, topic := range topics {
+		if topic.kind != valueString || strings.TrimSpace(topic.str) == "" {
+			if definition.list {
+				p.errorf(line, "the %q argument of @%s must only contain non-empty strings", definition.argument, name)
+			} else {
+				p.errorf(line, "the %q argument of @%s must be a non-empty string", definition.argument, name)
+			}
+			return
+		}
+		p.topics[definition.broker] = append(p.topics[definition.broker], topic.str)
+	}
+}
+
+// arguments parses "(name: value ...)".
+func (p *sdlParser) arguments() map[string]value {
+	arguments := map[string]value{}
+	p.next()
+	for !p.eof() && !p.peekIs(")") {
+		name := p.next()
+		if name.kind != tokenName || !p.peekIs(":") {
+			p.errorf(name.line, "invalid directive argument %q", name.value)
+			p.skipUntil(")")
+			return arguments
+		}
+		p.next()
+		arguments[name.value] = p.value()
+	}
+	p.next()
+	return arguments
+}
+
+func (p *sdlParser) value() value {
+	t := p.next()
+	switch {
+	case t.kind == tokenString:
+		return value{kind: valueString, str: t.value}
+	case t.kind == tokenPunctuator && t.value == "[":
+		v := value{kind: valueList}
+		for !p.eof() && !p.peekIs("]") {
+			v.list = append(v.list, p.value())
+		}
+		p.next()
+		return v
+	case t.kind == tokenPunctuator && t.value == "{":
+		v := value{kind: valueObject, fields: map[string]value{}}
+		for !p.eof() && !p.peekIs("}") {
+			name := p.next()
+			if p.peekIs(":") {
+				p.next()
+			}
+			v.fields[name.value] = p.value()
+		}
+		p.next()
+		return v
+	case t.kind == tokenPunctuator && t.value == "$":
+		p.next()
+	}
+	return value{kind: valueOther}
+}
+
+func (p *sdlParser) skipUntil(punctuator string) {
+	for !p.eof() && !p.peekIs(punctuator) {
+		p.next()
+	}
+	p.next()
+}
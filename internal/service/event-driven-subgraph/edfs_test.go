@@ -0,0 +1,239 @@
+package event_driven_subgraph
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+const edfsTestSchema = `
+directive @edfs__natsRequest(subject: String!, providerId: String! = "default") on FIELD_DEFINITION
+directive @edfs__natsSubscribe(subjects: [String!]!, providerId: String! = "default", streamConfiguration: edfs__NatsStreamConfiguration) on FIELD_DEFINITION
+directive @edfs__kafkaPublish(topic: String!, providerId: String! = "default") on FIELD_DEFINITION
+directive @edfs__kafkaSubscribe(topics: [String!]!, providerId: String! = "default") on FIELD_DEFINITION
+
+"""
+Events of employees, e.g. "updated".
+"""
+type Query {
+  # Requests an employee over NATS.
+  employeeFromEvent(id: Int!): Employee! @edfs__natsRequest(subject: "getEmployee.{{ args.id }}")
+}
+
+type Mutation {
+  updateEmployee(id: Int!, update: UpdateEmployeeInput!): edfs__PublishResult! @edfs__kafkaPublish(topic: "employeeUpdated", providerId: "my-kafka")
+}
+
+type Subscription {
+  employeeUpdated(id: Int!): Employee! @edfs__natsSubscribe(subjects: ["employeeUpdated.{{ args.id }}"], streamConfiguration: { consumerName: "consumer", streamName: "stream" })
+  employeeUpdatedKafka: Employee! @edfs__kafkaSubscribe(topics: ["employeeUpdated", "employeeCreated"], providerId: "my-kafka")
+}
+
+input UpdateEmployeeInput {
+  name: String = "unknown"
+}
+
+type Employee @key(fields: "id", resolvable: false) {
+  id: Int! @external
+}
+
+type edfs__PublishResult {
+  success: Boolean!
+}
+`
+
+func TestParseEDFSSchema(t *testing.T) {
+	schema, err := parseEDFSSchema(edfsTestSchema)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if topics := []string{"employeeCreated", "employeeUpdated"}; !slices.Equal(schema.KafkaTopics, topics) {
+		t.Errorf("expected Kafka topics %v, got %v", topics, schema.KafkaTopics)
+	}
+	if subjects := []string{"employeeUpdated.{{ args.id }}", "getEmployee.{{ args.id }}"}; !slices.Equal(schema.NatsSubjects, subjects) {
+		t.Errorf("expected NATS subjects %v, got %v", subjects, schema.NatsSubjects)
+	}
+	if len(schema.RedisChannels) != 0 {
+		t.Errorf("expected no Redis channels, got %v", schema.RedisChannels)
+	}
+}
+
+func TestParseEDFSSchemaSyntax(t *testing.T) {
+	tests := map[string]struct {
+		schema        string
+		kafkaTopics   []string
+		natsSubjects  []string
+		redisChannels []string
+	}{
+		"redis": {
+			schema: `
+type Mutation { a: edfs__PublishResult! @edfs__redisPublish(channel: "updated", providerId: "my-redis") }
+type Subscription {
+  a: A! @edfs__redisSubscribe(channels: ["updated", "created"])
+  b: A! @edfs__redisSubscribe(channels: "updated")
+}`,
+			redisChannels: []string{"created", "updated"},
+		},
+		"block strings": {
+			schema: `
+"""
+Events, e.g. @edfs__kafkaSubscribe(topics: ["ignored"]) or an escaped \""" quote.
+"""
+type Subscription {
+  """
+  { unbalanced
+  """
+  a: A! @edfs__kafkaSubscribe(topics: ["a"])
+}`,
+			kafkaTopics: []string{"a"},
+		},
+		"escaped quotes": {
+			schema:      `type Mutation { a: edfs__PublishResult! @edfs__kafkaPublish(topic: "a\"b\\c\u0021") }`,
+			kafkaTopics: []string{`a"b\c!`},
+		},
+		"field arguments": {
+			schema: `
+type Subscription {
+  a(id: ID! @deprecated(reason: "}"), filter: Filter = { ids: [1, 2], name: "a" }): A! @edfs__natsSubscribe(subjects: ["a.{{ args.id }}"])
+  b(ids: [ID!]! = []): A! @edfs__natsSubscribe(subjects: ["b"])
+}`,
+			natsSubjects: []string{"a.{{ args.id }}", "b"},
+		},
+		"extend type": {
+			schema: `
+extend type Query @key(fields: "id") { a: A! @edfs__natsRequest(subject: "a") }
+extend type Subscription { b: A! @edfs__kafkaSubscribe(topics: ["b"]) }`,
+			kafkaTopics:  []string{"b"},
+			natsSubjects: []string{"a"},
+		},
+		"renamed root types": {
+			schema: `
+type Events { a: A! @edfs__kafkaSubscribe(topics: ["a"]) }
+type Commands { b: edfs__PublishResult! @edfs__natsPublish(subject: "b") }
+schema @link(url: "https://specs.apollo.dev/federation/v2.5", import: ["@key"]) {
+  query: Query
+  subscription: Events
+}
+extend schema { mutation: Commands }`,
+			kafkaTopics:  []string{"a"},
+			natsSubjects: []string{"b"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			schema, err := parseEDFSSchema(tt.schema)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !slices.Equal(schema.KafkaTopics, tt.kafkaTopics) {
+				t.Errorf("expected Kafka topics %v, got %v", tt.kafkaTopics, schema.KafkaTopics)
+			}
+			if !slices.Equal(schema.NatsSubjects, tt.natsSubjects) {
+				t.Errorf("expected NATS subjects %v, got %v", tt.natsSubjects, schema.NatsSubjects)
+			}
+			if !slices.Equal(schema.RedisChannels, tt.redisChannels) {
+				t.Errorf("expected Redis channels %v, got %v", tt.redisChannels, schema.RedisChannels)
+			}
+		})
+	}
+}
+
+func TestParseEDFSSchemaInvalid(t *testing.T) {
+	tests := map[string]struct {
+		schema string
+		err    string
+	}{
+		"no directives": {
+			schema: `type Query { hello: String }`,
+			err:    "does not use any EDFS directive",
+		},
+		"unknown directive": {
+			schema: `type Subscription { a: A! @edfs__kafkaListen(topics: ["a"]) }`,
+			err:    "unknown EDFS directive @edfs__kafkaListen",
+		},
+		"wrong root type": {
+			schema: `type Query { a: A! @edfs__kafkaSubscribe(topics: ["a"]) }`,
+			err:    "can only be used on fields of the Subscription type",
+		},
+		"not on a field": {
+			schema: `type A @edfs__kafkaPublish(topic: "a") { id: ID! }`,
+			err:    "can only be used on fields of the Mutation type",
+		},
+		"missing argument": {
+			schema: `type Mutation { a: edfs__PublishResult! @edfs__natsPublish(providerId: "nats") }`,
+			err:    `requires the "subject" argument`,
+		},
+		"empty topic": {
+			schema: `type Mutation { a: edfs__PublishResult! @edfs__kafkaPublish(topic: "") }`,
+			err:    `must be a non-empty string`,
+		},
+		"empty list": {
+			schema: `type Subscription { a: A! @edfs__natsSubscribe(subjects: []) }`,
+			err:    `must be a non-empty list of strings`,
+		},
+		"list for single topic": {
+			schema: `type Mutation { a: edfs__PublishResult! @edfs__kafkaPublish(topic: ["a"]) }`,
+			err:    `must be a non-empty string`,
+		},
+		"unknown argument": {
+			schema: `type Subscription { a: A! @edfs__kafkaSubscribe(topics: ["a"], subjects: ["b"]) }`,
+			err:    `has no argument "subjects"`,
+		},
+		"empty provider": {
+			schema: `type Subscription { a: A! @edfs__kafkaSubscribe(topics: ["a"], providerId: "") }`,
+			err:    `"providerId" argument`,
+		},
+		"incomplete stream configuration": {
+			schema: `type Subscription { a: A! @edfs__natsSubscribe(subjects: ["a"], streamConfiguration: { consumerName: "c" }) }`,
+			err:    `requires a non-empty "streamName"`,
+		},
+		"renamed root type": {
+			schema: `schema { subscription: Events } type Subscription { a: A! @edfs__kafkaSubscribe(topics: ["a"]) }`,
+			err:    "can only be used on fields of the Events type",
+		},
+		"unterminated string": {
+			schema: `type Subscription { a: A! @edfs__kafkaSubscribe(topics: ["a]) }`,
+			err:    "unterminated string",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseEDFSSchema(tt.schema)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if !strings.Contains(err.Error(), tt.err) {
+				t.Fatalf("expected an error containing %q, got: %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func FuzzParseEDFSSchema(f *testing.F) {
+	f.Add(edfsTestSchema)
+	f.Add(`schema { subscription: Events } type Events { a: A! @edfs__redisSubscribe(channels: ["a"]) }`)
+	f.Add(`type Mutation { a: edfs__PublishResult! @edfs__kafkaPublish(topic: "a\"b") }`)
+	f.Add(`type Subscription { """ a \""" """ a(b: C = { d: [1] }): A! @edfs__natsSubscribe(subjects: ["a"]) }`)
+
+	f.Fuzz(func(t *testing.T, sdl string) {
+		schema, err := parseEDFSSchema(sdl)
+		if err != nil {
+			return
+		}
+
+		for _, topics := range [][]string{schema.KafkaTopics, schema.NatsSubjects, schema.RedisChannels} {
+			if !slices.IsSorted(topics) || len(slices.Compact(slices.Clone(topics))) != len(topics) {
+				t.Errorf("expected sorted topics without duplicates, got %v", topics)
+			}
+			for _, topic := range topics {
+				if strings.TrimSpace(topic) == "" {
+					t.Errorf("expected non-empty topics, got %q", topics)
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,14 @@
+package event_driven_subgraph
+
+const (
+	ErrCreatingSubgraph          = "Error Creating Subgraph"
+	ErrRetrievingSubgraph        = "Error Retrieving Subgraph"
+	ErrUpdatingSubgraph          = "Error Updating Subgraph"
+	ErrDeletingSubgraph          = "Error Deleting Subgraph"
+	ErrPublishingSubgraph        = "Error Publishing Subgraph"
+	ErrSubgraphCompositionFailed = "Subgraph Composition Failed"
+	ErrInvalidSubgraphType       = "Invalid Subgraph Type"
+	ErrInvalidEDFSSchema         = "Invalid Event-Driven Subgraph Schema"
+	ErrInvalidImportId           = "Invalid Import ID"
+	ErrUnexpectedDataSourceType  = "Unexpected Data Source Configure Type"
+)
@@ -0,0 +1,32 @@
+package event_driven_subgraph_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+func TestAccCosmoEventDrivenSubgraphImportBasic(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	subgraphName := acctest.RandomWithPrefix("test-subgraph")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventDrivenSubgraphResourceConfig(namespace, subgraphName, kafkaSubgraphSchema),
+			},
+			{
+				// import via namespace and name
+				ResourceName:      "cosmo_event_driven_subgraph.test",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s", namespace, subgraphName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
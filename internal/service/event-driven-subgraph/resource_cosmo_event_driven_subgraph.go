@@ -0,0 +1,409 @@
+package event_driven_subgraph
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
+)
+
+var (
+	_ resource.Resource                   = (*EventDrivenSubgraphResource)(nil)
+	_ resource.ResourceWithImportState    = (*EventDrivenSubgraphResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*EventDrivenSubgraphResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*EventDrivenSubgraphResource)(nil)
+)
+
+type EventDrivenSubgraphResource struct {
+	client *api.PlatformClient
+}
+
+type EventDrivenSubgraphResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Namespace     types.String `tfsdk:"namespace"`
+	Schema        types.String `tfsdk:"schema"`
+	Labels        types.Map    `tfsdk:"labels"`
+	Readme        types.String `tfsdk:"readme"`
+	KafkaTopics   types.List   `tfsdk:"kafka_topics"`
+	NatsSubjects  types.List   `tfsdk:"nats_subjects"`
+	RedisChannels types.List   `tfsdk:"redis_channels"`
+}
+
+func NewEventDrivenSubgraphResource() resource.Resource {
+	return &EventDrivenSubgraphResource{}
+}
+
+func (r *EventDrivenSubgraphResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_event_driven_subgraph"
+}
+
+func (r *EventDrivenSubgraphResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Creates an event-driven subgraph, which is resolved by the router from events of Kafka, NATS or Redis instead of a subgraph server, and publishes its schema.
+
+The EDFS directives of the schema are validated on plan: each directive must be known, used on a field of the matching root type (` + "`Query`" + `, ` + "`Mutation`" + ` or ` + "`Subscription`" + `, or the types a ` + "`schema`" + ` definition names instead) and declare non-empty topics, subjects or channels and provider ids. The declared Kafka topics, NATS subjects and Redis channels are exposed, e.g. to create them with the Terraform configuration of the brokers.
+
+For more information on event-driven subgraphs, please refer to the [Cosmo Documentation](https://cosmo-docs.wundergraph.com/router/event-driven-federated-subscriptions-edfs).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the subgraph.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the subgraph.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("default"),
+				MarkdownDescription: "The namespace of the subgraph.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schema": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The schema of the subgraph, declaring the events with EDFS directives such as `@edfs__kafkaSubscribe` or `@edfs__natsPublish`.",
+			},
+			"labels": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Labels for the subgraph.",
+			},
+			"readme": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The readme of the subgraph.",
+			},
+			"kafka_topics": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The Kafka topics declared in the schema, sorted and without duplicates.",
+			},
+			"nats_subjects": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The NATS subjects declared in the schema, sorted and without duplicates. Templates such as `{{ args.id }}` are kept as declared.",
+			},
+			"redis_channels": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The Redis channels declared in the schema, sorted and without duplicates. Templates such as `{{ args.id }}` are kept as declared.",
+			},
+		},
+	}
+}
+
+func (r *EventDrivenSubgraphResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.PlatformClient)
+	if !ok {
+		utils.AddDiagnosticError(resp, ErrUnexpectedDataSourceType, fmt.Sprintf("Expected *api.PlatformClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *EventDrivenSubgraphResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data EventDrivenSubgraphResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Schema.IsNull() || data.Schema.IsUnknown() {
+		return
+	}
+
+	if _, err := parseEDFSSchema(data.Schema.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("schema"), ErrInvalidEDFSSchema, err.Error())
+	}
+}
+
+// ModifyPlan plans the topics, subjects and channels declared in the
+// configured schema, so that they are known before the schema is published.
+func (r *EventDrivenSubgraphResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data EventDrivenSubgraphResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Schema.IsUnknown() {
+		return
+	}
+
+	// Invalid schemas are reported by ValidateConfig.
+	if setTopics(ctx, &data, &resp.Diagnostics) {
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
+	}
+}
+
+func (r *EventDrivenSubgraphResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data EventDrivenSubgraphResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	isEventDrivenGraph := true
+	apiError := r.client.CreateSubgraph(ctx, &platformv1.CreateFederatedSubgraphRequest{
+		Name:               data.Name.ValueString(),
+		Namespace:          data.Namespace.ValueString(),
+		Labels:             labelsFromModel(data.Labels),
+		Readme:             data.Readme.ValueStringPointer(),
+		IsEventDrivenGraph: &isEventDrivenGraph,
+	})
+	if apiError != nil {
+		utils.AddDiagnosticError(resp, ErrCreatingSubgraph, apiError.Error())
+		return
+	}
+
+	// A subgraph whose schema could not be published is deleted again, as
+	// Terraform would taint it and recreate it on the next apply anyway.
+	r.publish(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		if apiError := r.client.DeleteSubgraph(ctx, data.Name.ValueString(), data.Namespace.ValueString()); apiError != nil && !api.IsNotFoundError(apiError) {
+			utils.AddDiagnosticError(resp, ErrDeletingSubgraph, fmt.Sprintf("The subgraph '%s' was created but its schema could not be published, deleting it failed: %s", data.Name.ValueString(), apiError.Error()))
+		}
+		return
+	}
+
+	if !r.refresh(ctx, &data, &resp.Diagnostics) || data.Id.IsUnknown() {
+		return
+	}
+
+	utils.LogAction(ctx, "created", data.Id.ValueString(), data.Name.ValueString(), data.Namespace.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EventDrivenSubgraphResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EventDrivenSubgraphResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.refresh(ctx, &data, &resp.Diagnostics) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.LogAction(ctx, "read", data.Id.ValueString(), data.Name.ValueString(), data.Namespace.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EventDrivenSubgraphResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data EventDrivenSubgraphResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	labels := labelsFromModel(data.Labels)
+	unsetLabels := len(labels) == 0
+	readme := data.Readme.ValueString()
+	apiError := r.client.UpdateSubgraph(ctx, &platformv1.UpdateSubgraphRequest{
+		Name:        data.Name.ValueString(),
+		Namespace:   data.Namespace.ValueString(),
+		Labels:      labels,
+		UnsetLabels: &unsetLabels,
+		Readme:      &readme,
+		Headers:     []string{},
+	})
+	if apiError != nil {
+		if !api.IsSubgraphCompositionFailedError(apiError) {
+			utils.AddDiagnosticError(resp, ErrUpdatingSubgraph, apiError.Error())
+			return
+		}
+		utils.AddDiagnosticWarning(resp, ErrSubgraphCompositionFailed, apiError.Error())
+	}
+
+	r.publish(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.refresh(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.LogAction(ctx, "updated", data.Id.ValueString(), data.Name.ValueString(), data.Namespace.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EventDrivenSubgraphResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data EventDrivenSubgraphResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if apiError := r.client.DeleteSubgraph(ctx, data.Name.ValueString(), data.Namespace.ValueString()); apiError != nil {
+		switch {
+		case api.IsNotFoundError(apiError):
+			return
+		case api.IsSubgraphCompositionFailedError(apiError):
+			utils.AddDiagnosticWarning(resp, ErrSubgraphCompositionFailed, apiError.Error())
+		default:
+			utils.AddDiagnosticError(resp, ErrDeletingSubgraph, apiError.Error())
+			return
+		}
+	}
+
+	utils.LogAction(ctx, "deleted", data.Id.ValueString(), data.Name.ValueString(), data.Namespace.ValueString())
+}
+
+// ImportState imports an event-driven subgraph by "<namespace>/<name>".
+func (r *EventDrivenSubgraphResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		utils.AddDiagnosticError(resp, ErrInvalidImportId, fmt.Sprintf("Expected an import ID of the form <namespace>/<name>, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+}
+
+// publish publishes the planned schema. A failed composition is reported as
+// a warning, the schema is published regardless.
+func (r *EventDrivenSubgraphResource) publish(ctx context.Context, data *EventDrivenSubgraphResourceModel, diags *diag.Diagnostics) {
+	_, apiError := r.client.PublishSubgraph(ctx, data.Name.ValueString(), data.Namespace.ValueString(), data.Schema.ValueString())
+	if apiError == nil {
+		return
+	}
+
+	switch {
+	case api.IsSubgraphCompositionFailedError(apiError):
+		diags.AddWarning(ErrSubgraphCompositionFailed, apiError.Error())
+	case api.IsInvalidSubgraphSchemaError(apiError):
+		diags.AddError(ErrInvalidEDFSSchema, apiError.Error())
+	default:
+		diags.AddError(ErrPublishingSubgraph, apiError.Error())
+	}
+}
+
+// refresh reads the subgraph and its schema into the model. It reports false
+// if the subgraph does not exist.
+func (r *EventDrivenSubgraphResource) refresh(ctx context.Context, data *EventDrivenSubgraphResourceModel, diags *diag.Diagnostics) bool {
+	name, namespace := data.Name.ValueString(), data.Namespace.ValueString()
+
+	subgraph, apiError := r.client.GetSubgraph(ctx, name, namespace)
+	if apiError != nil {
+		if api.IsNotFoundError(apiError) {
+			return false
+		}
+		diags.AddError(ErrRetrievingSubgraph, apiError.Error())
+		return true
+	}
+
+	if !subgraph.GetIsEventDrivenGraph() {
+		diags.AddError(ErrInvalidSubgraphType, fmt.Sprintf("Subgraph '%s' is not an event-driven subgraph, please manage it with cosmo_subgraph", name))
+		return true
+	}
+
+	subgraphSchema, apiError := r.client.GetSubgraphSchema(ctx, name, namespace)
+	if apiError != nil {
+		if api.IsNotFoundError(apiError) {
+			return false
+		}
+		diags.AddError(ErrRetrievingSubgraph, apiError.Error())
+		return true
+	}
+
+	labels := map[string]attr.Value{}
+	for _, label := range subgraph.GetLabels() {
+		if label != nil {
+			labels[label.GetKey()] = types.StringValue(label.GetValue())
+		}
+	}
+	if len(labels) > 0 || !data.Labels.IsNull() {
+		data.Labels = types.MapValueMust(types.StringType, labels)
+	}
+
+	data.Id = types.StringValue(subgraph.GetId())
+	if subgraph.Readme != nil && (subgraph.GetReadme() != "" || !data.Readme.IsNull()) {
+		data.Readme = types.StringValue(subgraph.GetReadme())
+	}
+	if len(subgraphSchema) > 0 {
+		data.Schema = types.StringValue(subgraphSchema)
+	}
+
+	setTopics(ctx, data, diags)
+	return true
+}
+
+// setTopics sets the topics, subjects and channels declared in the schema of
+// the model.
+// It reports false and leaves the model unchanged if the schema is invalid.
+func setTopics(ctx context.Context, data *EventDrivenSubgraphResourceModel, diags *diag.Diagnostics) bool {
+	edfs, err := parseEDFSSchema(data.Schema.ValueString())
+	if err != nil {
+		return false
+	}
+
+	kafkaTopics, d := types.ListValueFrom(ctx, types.StringType, edfs.KafkaTopics)
+	diags.Append(d...)
+	natsSubjects, d := types.ListValueFrom(ctx, types.StringType, edfs.NatsSubjects)
+	diags.Append(d...)
+	redisChannels, d := types.ListValueFrom(ctx, types.StringType, edfs.RedisChannels)
+	diags.Append(d...)
+
+	data.KafkaTopics = kafkaTopics
+	data.NatsSubjects = natsSubjects
+	data.RedisChannels = redisChannels
+	return true
+}
+
+func labelsFromModel(labels types.Map) []*platformv1.Label {
+	var result []*platformv1.Label
+	for key, value := range labels.Elements() {
+		if strValue, ok := value.(types.String); ok {
+			result = append(result, &platformv1.Label{
+				Key:   key,
+				Value: strValue.ValueString(),
+			})
+		}
+	}
+	return result
+}
@@ -0,0 +1,118 @@
+package event_driven_subgraph_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+const kafkaSubgraphSchema = `
+directive @edfs__kafkaPublish(topic: String!, providerId: String! = "default") on FIELD_DEFINITION
+directive @edfs__kafkaSubscribe(topics: [String!]!, providerId: String! = "default") on FIELD_DEFINITION
+
+type Mutation {
+  updateEmployee(id: Int!): edfs__PublishResult! @edfs__kafkaPublish(topic: "employeeUpdated", providerId: "my-kafka")
+}
+
+type Subscription {
+  employeeUpdated(id: Int!): Employee! @edfs__kafkaSubscribe(topics: ["employeeUpdated", "employeeCreated"], providerId: "my-kafka")
+}
+
+type Employee @key(fields: "id", resolvable: false) {
+  id: Int! @external
+}
+
+type edfs__PublishResult {
+  success: Boolean!
+}
+`
+
+func TestAccEventDrivenSubgraphResource(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	subgraphName := acctest.RandomWithPrefix("test-subgraph")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventDrivenSubgraphResourceConfig(namespace, subgraphName, acceptance.TestAccValidEventDrivenSubgraphSchema),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_event_driven_subgraph.test", "name", subgraphName),
+					resource.TestCheckResourceAttr("cosmo_event_driven_subgraph.test", "namespace", namespace),
+					resource.TestCheckResourceAttr("cosmo_event_driven_subgraph.test", "labels.team", "backend"),
+					resource.TestCheckResourceAttrSet("cosmo_event_driven_subgraph.test", "id"),
+					resource.TestCheckResourceAttr("cosmo_event_driven_subgraph.test", "kafka_topics.#", "2"),
+					resource.TestCheckResourceAttr("cosmo_event_driven_subgraph.test", "kafka_topics.0", "employeeUpdated"),
+					resource.TestCheckResourceAttr("cosmo_event_driven_subgraph.test", "nats_subjects.#", "6"),
+					resource.TestCheckResourceAttr("cosmo_event_driven_subgraph.test", "redis_channels.#", "2"),
+					resource.TestCheckResourceAttr("cosmo_event_driven_subgraph.test", "redis_channels.0", "employeeUpdatedMyRedis"),
+				),
+			},
+			{
+				Config: testAccEventDrivenSubgraphResourceConfig(namespace, subgraphName, kafkaSubgraphSchema),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_event_driven_subgraph.test", "kafka_topics.#", "2"),
+					resource.TestCheckResourceAttr("cosmo_event_driven_subgraph.test", "kafka_topics.0", "employeeCreated"),
+					resource.TestCheckResourceAttr("cosmo_event_driven_subgraph.test", "nats_subjects.#", "0"),
+					resource.TestCheckResourceAttr("cosmo_event_driven_subgraph.test", "redis_channels.#", "0"),
+				),
+			},
+			{
+				ResourceName: "cosmo_event_driven_subgraph.test",
+				RefreshState: true,
+			},
+			{
+				Config:  testAccEventDrivenSubgraphResourceConfig(namespace, subgraphName, kafkaSubgraphSchema),
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func TestAccEventDrivenSubgraphResourceInvalidSchema(t *testing.T) {
+	schemas := map[string]string{
+		"no directives":   `type Query { hello: String }`,
+		"wrong root type": `type Query { a: A! @edfs__kafkaSubscribe(topics: ["a"]) }`,
+		"empty subject":   `type Mutation { a: edfs__PublishResult! @edfs__natsPublish(subject: "") }`,
+	}
+
+	for name, subgraphSchema := range schemas {
+		t.Run(name, func(t *testing.T) {
+			resource.ParallelTest(t, resource.TestCase{
+				PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+				ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config:      testAccEventDrivenSubgraphResourceConfig("test-namespace", "test-subgraph", subgraphSchema),
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile(`Invalid Event-Driven Subgraph Schema`),
+					},
+				},
+			})
+		})
+	}
+}
+
+func testAccEventDrivenSubgraphResourceConfig(namespace, subgraphName, subgraphSchema string) string {
+	return fmt.Sprintf(`
+resource "cosmo_namespace" "test" {
+  name = "%s"
+}
+
+resource "cosmo_event_driven_subgraph" "test" {
+  name      = "%s"
+  namespace = cosmo_namespace.test.name
+  labels    = {
+    "team" = "backend"
+  }
+  schema    = <<-EOT
+%sEOT
+}
+`, namespace, subgraphName, subgraphSchema)
+}
@@ -0,0 +1,8 @@
+package feature_flag_state
+
+const (
+	ErrSettingFeatureFlagState  = "Error Setting Feature Flag State"
+	ErrReadingFeatureFlagState  = "Error Reading Feature Flag State"
+	ErrInvalidImportId          = "Invalid Import ID"
+	ErrUnexpectedDataSourceType = "Unexpected Data Source Configure Type"
+)
@@ -0,0 +1,31 @@
+package feature_flag_state_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+func TestAccCosmoFeatureFlagStateImportBasic(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	ffName := acctest.RandomWithPrefix("test-feature-flag")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeatureFlagStateResourceConfig(namespace, ffName, true),
+			},
+			{
+				// import via namespace and name, like the feature flag itself
+				ResourceName:      "cosmo_feature_flag_state.test",
+				ImportState:       true,
+				ImportStateId:     namespace + "." + ffName,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
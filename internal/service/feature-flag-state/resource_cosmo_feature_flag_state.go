@@ -0,0 +1,208 @@
+package feature_flag_state
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
+)
+
+var (
+	_ resource.Resource                = (*FeatureFlagStateResource)(nil)
+	_ resource.ResourceWithImportState = (*FeatureFlagStateResource)(nil)
+)
+
+type FeatureFlagStateResource struct {
+	client *api.PlatformClient
+}
+
+type FeatureFlagStateResourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Namespace types.String `tfsdk:"namespace"`
+	Enabled   types.Bool   `tfsdk:"enabled"`
+}
+
+func NewFeatureFlagStateResource() resource.Resource {
+	return &FeatureFlagStateResource{}
+}
+
+func (r *FeatureFlagStateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feature_flag_state"
+}
+
+func (r *FeatureFlagStateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Enables or disables a feature flag. The feature flag itself is defined with ` + "`cosmo_feature_flag`" + `, which must not configure ` + "`is_enabled`" + ` when the state is managed with this resource.
+
+Destroying the resource leaves the feature flag in its last state.
+
+For more information on feature flags, please refer to the [Cosmo Documentation](https://cosmo-docs.wundergraph.com/cli/feature-flags).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the feature flag.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the feature flag.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("default"),
+				MarkdownDescription: "The namespace of the feature flag.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Required:            true,
+				MarkdownDescription: "Whether the feature flag is enabled.",
+			},
+		},
+	}
+}
+
+func (r *FeatureFlagStateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.PlatformClient)
+	if !ok {
+		utils.AddDiagnosticError(resp, ErrUnexpectedDataSourceType, fmt.Sprintf("Expected *api.PlatformClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FeatureFlagStateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FeatureFlagStateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.LogAction(ctx, "created", data.Id.ValueString(), data.Name.ValueString(), data.Namespace.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FeatureFlagStateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FeatureFlagStateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ff, apiError := r.client.GetFeatureFlag(ctx, data.Name.ValueString(), data.Namespace.ValueString())
+	if apiError != nil {
+		if api.IsNotFoundError(apiError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddDiagnosticError(resp, ErrReadingFeatureFlagState, apiError.Error())
+		return
+	}
+
+	data.Id = types.StringValue(ff.GetId())
+	data.Enabled = types.BoolValue(ff.GetIsEnabled())
+
+	utils.LogAction(ctx, "read", data.Id.ValueString(), data.Name.ValueString(), data.Namespace.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FeatureFlagStateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FeatureFlagStateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.LogAction(ctx, "updated", data.Id.ValueString(), data.Name.ValueString(), data.Namespace.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FeatureFlagStateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FeatureFlagStateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The feature flag is left in its last state.
+	utils.LogAction(ctx, "deleted", data.Id.ValueString(), data.Name.ValueString(), data.Namespace.ValueString())
+}
+
+// ImportState imports the state of a feature flag by "<namespace>.<name>",
+// the same format as the feature flag itself.
+func (r *FeatureFlagStateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	namespace, name, found := strings.Cut(req.ID, ".")
+	if !found || namespace == "" || name == "" {
+		utils.AddDiagnosticError(resp, ErrInvalidImportId, fmt.Sprintf("Expected an import ID of the form <namespace>.<name>, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+// apply sets the planned state of the feature flag. A failed composition is
+// reported as a warning, as the flag is enabled or disabled regardless.
+func (r *FeatureFlagStateResource) apply(ctx context.Context, data *FeatureFlagStateResourceModel, diags *diag.Diagnostics) {
+	name, namespace := data.Name.ValueString(), data.Namespace.ValueString()
+
+	ff, apiError := r.client.GetFeatureFlag(ctx, name, namespace)
+	if apiError != nil {
+		diags.AddError(ErrReadingFeatureFlagState, apiError.Error())
+		return
+	}
+
+	if ff.GetIsEnabled() != data.Enabled.ValueBool() {
+		if apiError := r.client.SetFeatureFlagState(ctx, name, namespace, data.Enabled.ValueBool()); apiError != nil {
+			if !api.IsSubgraphCompositionFailedError(apiError) {
+				diags.AddError(ErrSettingFeatureFlagState, apiError.Error())
+				return
+			}
+			diags.AddWarning(ErrSettingFeatureFlagState, apiError.Error())
+		}
+	}
+
+	data.Id = types.StringValue(ff.GetId())
+}
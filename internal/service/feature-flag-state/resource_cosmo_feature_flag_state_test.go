@@ -0,0 +1,117 @@
+package feature_flag_state_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+func TestAccFeatureFlagStateResource(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	ffName := acctest.RandomWithPrefix("test-feature-flag")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeatureFlagStateResourceConfig(namespace, ffName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_feature_flag_state.test", "name", ffName),
+					resource.TestCheckResourceAttr("cosmo_feature_flag_state.test", "namespace", namespace),
+					resource.TestCheckResourceAttr("cosmo_feature_flag_state.test", "enabled", "true"),
+					resource.TestCheckResourceAttrPair("cosmo_feature_flag_state.test", "id", "cosmo_feature_flag.test", "id"),
+					resource.TestCheckNoResourceAttr("cosmo_feature_flag.test", "is_enabled"),
+				),
+			},
+			{
+				Config: testAccFeatureFlagStateResourceConfig(namespace, ffName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_feature_flag_state.test", "enabled", "false"),
+					resource.TestCheckNoResourceAttr("cosmo_feature_flag.test", "is_enabled"),
+				),
+			},
+			{
+				ResourceName: "cosmo_feature_flag_state.test",
+				RefreshState: true,
+			},
+			{
+				Config:  testAccFeatureFlagStateResourceConfig(namespace, ffName, false),
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func TestAccFeatureFlagStateResourceUnknownFlag(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "cosmo_namespace" "test" {
+  name = "%s"
+}
+
+resource "cosmo_feature_flag_state" "test" {
+  name      = "unknown"
+  namespace = cosmo_namespace.test.name
+  enabled   = true
+}
+`, namespace),
+				ExpectError: regexp.MustCompile(`Error Reading Feature Flag State`),
+			},
+		},
+	})
+}
+
+func testAccFeatureFlagStateResourceConfig(namespace, ffName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "cosmo_namespace" "test" {
+  name = "%s"
+}
+
+resource "cosmo_subgraph" "test" {
+  name        = "%s-subgraph"
+  namespace   = cosmo_namespace.test.name
+  routing_url = "http://localhost:3000"
+  schema      = <<-EOT
+%sEOT
+  labels      = {
+    "team" = "backend"
+  }
+}
+
+resource "cosmo_feature_subgraph" "test" {
+  name               = "%s-feature-subgraph"
+  namespace          = cosmo_namespace.test.name
+  routing_url        = "http://localhost:3000"
+  base_subgraph_name = cosmo_subgraph.test.name
+  schema             = <<-EOT
+%sEOT
+}
+
+resource "cosmo_feature_flag" "test" {
+  name              = "%s"
+  namespace         = cosmo_namespace.test.name
+  feature_subgraphs = [cosmo_feature_subgraph.test.name]
+  labels            = {
+    "team" = "backend"
+  }
+}
+
+resource "cosmo_feature_flag_state" "test" {
+  name      = cosmo_feature_flag.test.name
+  namespace = cosmo_namespace.test.name
+  enabled   = %t
+}
+`, namespace, ffName, acceptance.TestAccValidSubgraphSchema, ffName, acceptance.TestAccValidSubgraphSchema, ffName, enabled)
+}
@@ -102,7 +102,7 @@ federated graphs can be associated with the feature flag to enabled calls agains
 				Optional:    true,
 			},
 			"is_enabled": schema.BoolAttribute{
-				MarkdownDescription: "Indicates whether the feature flag is enabled.",
+				MarkdownDescription: "Indicates whether the feature flag is enabled. Leave unset if the state of the feature flag is managed with `cosmo_feature_flag_state`.",
 				Optional:            true,
 			},
 			"created_by": schema.StringAttribute{
@@ -292,7 +292,7 @@ func (r *FeatureFlagResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	if ff.IsEnabled != data.IsEnabled.ValueBool() {
+	if !data.IsEnabled.IsNull() && ff.IsEnabled != data.IsEnabled.ValueBool() {
 		apiErr = r.client.SetFeatureFlagState(ctx, data.Name.ValueString(), data.Namespace.ValueString(), data.IsEnabled.ValueBool())
 		if apiErr != nil {
 			if api.IsNotFoundError(apiErr) {
@@ -373,6 +373,10 @@ func (r *FeatureFlagResource) ImportState(ctx context.Context, req resource.Impo
 
 func mapFeatureFlagToResourceModel(ff *api.FeatureFlag, res *FeatureFlagResourceModel) diag.Diagnostics {
 	var diags diag.Diagnostics
+
+	// The state of the flag may be managed with cosmo_feature_flag_state
+	// instead, it is only tracked if it is configured on the flag or on import.
+	trackIsEnabled := !res.IsEnabled.IsNull() || res.ID.IsNull()
 	tt := make([]attr.Value, 0, len(ff.FeatureSubgraphNames))
 
 	for _, subgraphName := range ff.FeatureSubgraphNames {
@@ -396,7 +400,9 @@ func mapFeatureFlagToResourceModel(ff *api.FeatureFlag, res *FeatureFlagResource
 	res.Namespace = types.StringValue(ff.Namespace)
 	res.FeatureSubgraphs = fsg
 	res.Labels = labels
-	res.IsEnabled = types.BoolValue(ff.IsEnabled)
+	if trackIsEnabled {
+		res.IsEnabled = types.BoolValue(ff.IsEnabled)
+	}
 	res.CreatedBy = types.StringValue(ff.CreatedBy)
 	res.CreatedAt = types.StringValue(ff.CreatedAt)
 	res.UpdatedAt = types.StringValue(ff.UpdatedAt)
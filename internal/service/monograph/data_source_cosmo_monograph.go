@@ -147,7 +147,7 @@ func (d *MonographDataSource) Read(ctx context.Context, req datasource.ReadReque
 	data.Name = types.StringValue(monograph.GetName())
 	data.Namespace = types.StringValue(monograph.GetNamespace())
 	data.RoutingURL = types.StringValue(monograph.GetRoutingURL())
-	data.Readme = types.StringValue(monograph.GetReadme())
+	data.Readme = types.StringPointerValue(monograph.Readme)
 	data.AdmissionWebhookURL = types.StringValue(monograph.GetAdmissionWebhookUrl())
 
 	tflog.Trace(ctx, "Read monograph data source", map[string]interface{}{
@@ -0,0 +1,8 @@
+package namespace_graph_pruning_config
+
+const (
+	ErrConfiguringGraphPruning     = "Error Configuring Namespace Graph Pruning"
+	ErrReadingGraphPruningConfig   = "Error Reading Namespace Graph Pruning Config"
+	ErrResettingGraphPruningConfig = "Error Resetting Namespace Graph Pruning Config"
+	ErrUnexpectedDataSourceType    = "Unexpected Data Source Configure Type"
+)
@@ -0,0 +1,30 @@
+package namespace_graph_pruning_config_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+func TestAccCosmoNamespaceGraphPruningConfigImportBasic(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNamespaceGraphPruningConfigResourceConfig(namespace, true, "warn", 7),
+			},
+			{
+				// import via namespace name
+				ResourceName:      "cosmo_namespace_graph_pruning_config.test",
+				ImportState:       true,
+				ImportStateId:     namespace,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,371 @@
+package namespace_graph_pruning_config
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
+)
+
+var (
+	_ resource.Resource                = (*NamespaceGraphPruningConfigResource)(nil)
+	_ resource.ResourceWithImportState = (*NamespaceGraphPruningConfigResource)(nil)
+)
+
+type NamespaceGraphPruningConfigResource struct {
+	client *api.PlatformClient
+}
+
+type NamespaceGraphPruningConfigResourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	Namespace types.String `tfsdk:"namespace"`
+	Enabled   types.Bool   `tfsdk:"enabled"`
+	Rules     types.Map    `tfsdk:"rules"`
+}
+
+type GraphPruningRuleModel struct {
+	Severity                     types.String `tfsdk:"severity"`
+	GracePeriodInDays            types.Int64  `tfsdk:"grace_period_in_days"`
+	SchemaUsageCheckPeriodInDays types.Int64  `tfsdk:"schema_usage_check_period_in_days"`
+}
+
+var graphPruningRuleType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"severity":                          types.StringType,
+		"grace_period_in_days":              types.Int64Type,
+		"schema_usage_check_period_in_days": types.Int64Type,
+	},
+}
+
+func NewNamespaceGraphPruningConfigResource() resource.Resource {
+	return &NamespaceGraphPruningConfigResource{}
+}
+
+func (r *NamespaceGraphPruningConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_namespace_graph_pruning_config"
+}
+
+func (r *NamespaceGraphPruningConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Configures graph pruning of a namespace. Graph pruning reports unused fields, deprecated fields past their grace period and fields removed without prior deprecation during schema checks.
+
+The rules are only applied while graph pruning is enabled. Disabling graph pruning removes the rules from the namespace, they are applied again once it is enabled.
+
+Destroying the resource disables graph pruning and removes all rules of the namespace.
+
+For more information on graph pruning, please refer to the [Cosmo Documentation](https://cosmo-docs.wundergraph.com/studio/graph-pruning).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the graph pruning config, which is the name of its namespace.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the namespace to configure graph pruning for.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether graph pruning is enabled for the namespace.",
+			},
+			"rules": schema.MapNestedAttribute{
+				Optional: true,
+				MarkdownDescription: fmt.Sprintf("The graph pruning rules to apply, keyed by the rule name: `%s`, `%s` or `%s`.",
+					api.GraphPruningRuleUnusedFields, api.GraphPruningRuleDeprecatedFields, api.GraphPruningRuleRequireDeprecationBeforeDeletion),
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(
+						stringvalidator.OneOf(api.GraphPruningRuleUnusedFields, api.GraphPruningRuleDeprecatedFields, api.GraphPruningRuleRequireDeprecationBeforeDeletion),
+					),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"severity": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: fmt.Sprintf("The severity of violations of the rule: `%s` or `%s`.", api.LintSeverityWarn, api.LintSeverityError),
+							Validators: []validator.String{
+								stringvalidator.OneOf(api.LintSeverityWarn, api.LintSeverityError),
+							},
+						},
+						"grace_period_in_days": schema.Int64Attribute{
+							Required:            true,
+							MarkdownDescription: fmt.Sprintf("The number of days a new or deprecated field is exempt from the rule, one of %s.", periodsInDays()),
+							Validators: []validator.Int64{
+								int64validator.OneOf(api.GraphPruningPeriodsInDays...),
+							},
+						},
+						"schema_usage_check_period_in_days": schema.Int64Attribute{
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: fmt.Sprintf("The number of days of client traffic considered to decide whether a field is used, one of %s. It cannot exceed the analytics retention of the organization's plan. Defaults to the value chosen by the platform.", periodsInDays()),
+							Validators: []validator.Int64{
+								int64validator.OneOf(api.GraphPruningPeriodsInDays...),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *NamespaceGraphPruningConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.PlatformClient)
+	if !ok {
+		utils.AddDiagnosticError(resp, ErrUnexpectedDataSourceType, fmt.Sprintf("Expected *api.PlatformClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NamespaceGraphPruningConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NamespaceGraphPruningConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.LogAction(ctx, "created", data.Id.ValueString(), "graph pruning config", data.Namespace.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NamespaceGraphPruningConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NamespaceGraphPruningConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, apiError := r.client.GetNamespaceGraphPruningConfig(ctx, data.Namespace.ValueString())
+	if apiError != nil {
+		if api.IsNotFoundError(apiError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddDiagnosticError(resp, ErrReadingGraphPruningConfig, apiError.Error())
+		return
+	}
+
+	data.Id = types.StringValue(data.Namespace.ValueString())
+	data.Enabled = types.BoolValue(config.GraphPrunerEnabled)
+	// The rules are not applied while graph pruning is disabled, the ones of
+	// the configuration are kept until it is enabled again.
+	if config.GraphPrunerEnabled {
+		data.Rules = mapRules(ctx, config.Configs, data.Rules, &resp.Diagnostics)
+	}
+
+	utils.LogAction(ctx, "read", data.Id.ValueString(), "graph pruning config", data.Namespace.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NamespaceGraphPruningConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NamespaceGraphPruningConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.LogAction(ctx, "updated", data.Id.ValueString(), "graph pruning config", data.Namespace.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NamespaceGraphPruningConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NamespaceGraphPruningConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiError := r.disable(ctx, data.Namespace.ValueString())
+	if apiError != nil {
+		if api.IsNotFoundError(apiError) {
+			return
+		}
+		utils.AddDiagnosticError(resp, ErrResettingGraphPruningConfig, apiError.Error())
+		return
+	}
+
+	utils.LogAction(ctx, "deleted", data.Id.ValueString(), "graph pruning config", data.Namespace.ValueString())
+}
+
+func (r *NamespaceGraphPruningConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), req.ID)...)
+}
+
+// apply enables graph pruning and replaces the rules of the namespace with
+// the configured ones, or disables it.
+func (r *NamespaceGraphPruningConfigResource) apply(ctx context.Context, data *NamespaceGraphPruningConfigResourceModel, diags *diag.Diagnostics) {
+	var rules map[string]GraphPruningRuleModel
+	if !data.Rules.IsNull() {
+		diags.Append(data.Rules.ElementsAs(ctx, &rules, false)...)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	configs := make([]*platformv1.GraphPruningConfig, 0, len(rules))
+	for name, rule := range rules {
+		config := &platformv1.GraphPruningConfig{
+			RuleName:          name,
+			SeverityLevel:     api.ResolveLintSeverity(rule.Severity.ValueString()),
+			GracePeriodInDays: int32(rule.GracePeriodInDays.ValueInt64()),
+		}
+		if !rule.SchemaUsageCheckPeriodInDays.IsNull() && !rule.SchemaUsageCheckPeriodInDays.IsUnknown() {
+			period := int32(rule.SchemaUsageCheckPeriodInDays.ValueInt64())
+			config.SchemaUsageCheckPeriodInDays = &period
+		}
+		configs = append(configs, config)
+	}
+
+	namespace := data.Namespace.ValueString()
+	data.Id = types.StringValue(namespace)
+
+	if !data.Enabled.ValueBool() {
+		if apiError := r.disable(ctx, namespace); apiError != nil {
+			diags.AddError(ErrConfiguringGraphPruning, apiError.Error())
+			return
+		}
+		data.Rules = withoutUnknownPeriods(ctx, data.Rules, diags)
+		return
+	}
+
+	if apiError := r.client.EnableNamespaceGraphPruning(ctx, namespace, true); apiError != nil {
+		diags.AddError(ErrConfiguringGraphPruning, apiError.Error())
+		return
+	}
+	if apiError := r.client.ConfigureNamespaceGraphPruningRules(ctx, namespace, configs); apiError != nil {
+		diags.AddError(ErrConfiguringGraphPruning, apiError.Error())
+		return
+	}
+
+	// Read the rules back to learn the periods the platform defaulted.
+	config, apiError := r.client.GetNamespaceGraphPruningConfig(ctx, namespace)
+	if apiError != nil {
+		diags.AddError(ErrReadingGraphPruningConfig, apiError.Error())
+		return
+	}
+
+	data.Rules = mapRules(ctx, config.Configs, data.Rules, diags)
+}
+
+// disable removes the rules of the namespace while graph pruning is still
+// enabled and disables it afterwards. Rules are never configured on a
+// namespace with graph pruning disabled.
+func (r *NamespaceGraphPruningConfigResource) disable(ctx context.Context, namespace string) *api.ApiError {
+	config, apiError := r.client.GetNamespaceGraphPruningConfig(ctx, namespace)
+	if apiError != nil {
+		return apiError
+	}
+
+	if config.GraphPrunerEnabled && len(config.Configs) > 0 {
+		if apiError := r.client.ConfigureNamespaceGraphPruningRules(ctx, namespace, nil); apiError != nil {
+			return apiError
+		}
+	}
+
+	return r.client.EnableNamespaceGraphPruning(ctx, namespace, false)
+}
+
+// withoutUnknownPeriods settles the schema usage check periods the platform
+// would have defaulted to null, as the rules are not applied while graph
+// pruning is disabled.
+func withoutUnknownPeriods(ctx context.Context, current types.Map, diags *diag.Diagnostics) types.Map {
+	if current.IsNull() || current.IsUnknown() {
+		return types.MapNull(graphPruningRuleType)
+	}
+
+	var rules map[string]GraphPruningRuleModel
+	diags.Append(current.ElementsAs(ctx, &rules, false)...)
+	for name, rule := range rules {
+		if rule.SchemaUsageCheckPeriodInDays.IsUnknown() {
+			rule.SchemaUsageCheckPeriodInDays = types.Int64Null()
+			rules[name] = rule
+		}
+	}
+
+	value, d := types.MapValueFrom(ctx, graphPruningRuleType, rules)
+	diags.Append(d...)
+
+	return value
+}
+
+func periodsInDays() string {
+	periods := make([]string, 0, len(api.GraphPruningPeriodsInDays))
+	for _, period := range api.GraphPruningPeriodsInDays {
+		periods = append(periods, fmt.Sprintf("`%d`", period))
+	}
+	return strings.Join(periods, ", ")
+}
+
+// mapRules converts the rules of the platform into the map of the resource,
+// leaving an unset map null while the namespace has no rules.
+func mapRules(ctx context.Context, configs []*platformv1.GraphPruningConfig, current types.Map, diags *diag.Diagnostics) types.Map {
+	if len(configs) == 0 && current.IsNull() {
+		return types.MapNull(graphPruningRuleType)
+	}
+
+	rules := make(map[string]GraphPruningRuleModel, len(configs))
+	for _, config := range configs {
+		rule := GraphPruningRuleModel{
+			Severity:                     types.StringValue(api.LintSeverityString(config.SeverityLevel)),
+			GracePeriodInDays:            types.Int64Value(int64(config.GracePeriodInDays)),
+			SchemaUsageCheckPeriodInDays: types.Int64Null(),
+		}
+		if config.SchemaUsageCheckPeriodInDays != nil {
+			rule.SchemaUsageCheckPeriodInDays = types.Int64Value(int64(*config.SchemaUsageCheckPeriodInDays))
+		}
+		rules[config.RuleName] = rule
+	}
+
+	value, d := types.MapValueFrom(ctx, graphPruningRuleType, rules)
+	diags.Append(d...)
+
+	return value
+}
@@ -0,0 +1,140 @@
+package namespace_graph_pruning_config_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+func TestAccNamespaceGraphPruningConfigResource(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNamespaceGraphPruningConfigResourceConfig(namespace, true, "warn", 7),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_namespace_graph_pruning_config.test", "id", namespace),
+					resource.TestCheckResourceAttr("cosmo_namespace_graph_pruning_config.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("cosmo_namespace_graph_pruning_config.test", "rules.UNUSED_FIELDS.severity", "warn"),
+					resource.TestCheckResourceAttr("cosmo_namespace_graph_pruning_config.test", "rules.UNUSED_FIELDS.grace_period_in_days", "7"),
+					resource.TestCheckResourceAttr("cosmo_namespace_graph_pruning_config.test", "rules.UNUSED_FIELDS.schema_usage_check_period_in_days", "7"),
+				),
+			},
+			{
+				ResourceName: "cosmo_namespace_graph_pruning_config.test",
+				RefreshState: true,
+			},
+			{
+				Config: testAccNamespaceGraphPruningConfigResourceConfig(namespace, true, "error", 14),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_namespace_graph_pruning_config.test", "rules.UNUSED_FIELDS.severity", "error"),
+					resource.TestCheckResourceAttr("cosmo_namespace_graph_pruning_config.test", "rules.UNUSED_FIELDS.grace_period_in_days", "14"),
+				),
+			},
+			{
+				Config: testAccNamespaceGraphPruningConfigResourceConfig(namespace, false, "error", 14),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_namespace_graph_pruning_config.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("cosmo_namespace_graph_pruning_config.test", "rules.UNUSED_FIELDS.grace_period_in_days", "14"),
+				),
+			},
+			{
+				Config:  testAccNamespaceGraphPruningConfigResourceConfig(namespace, false, "error", 14),
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func TestAccNamespaceGraphPruningConfigResourceInvalidRule(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "cosmo_namespace_graph_pruning_config" "test" {
+  namespace = "default"
+  rules = {
+    UNKNOWN_RULE = {
+      severity             = "warn"
+      grace_period_in_days = 7
+    }
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+		},
+	})
+}
+
+func TestAccNamespaceGraphPruningConfigResourceInvalidPeriod(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "cosmo_namespace_graph_pruning_config" "test" {
+  namespace = "default"
+  rules = {
+    UNUSED_FIELDS = {
+      severity             = "warn"
+      grace_period_in_days = 5
+    }
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+			{
+				Config: `
+resource "cosmo_namespace_graph_pruning_config" "test" {
+  namespace = "default"
+  rules = {
+    UNUSED_FIELDS = {
+      severity                          = "warn"
+      grace_period_in_days              = 7
+      schema_usage_check_period_in_days = 365
+    }
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+		},
+	})
+}
+
+func testAccNamespaceGraphPruningConfigResourceConfig(namespace string, enabled bool, severity string, gracePeriod int) string {
+	return fmt.Sprintf(`
+resource "cosmo_namespace" "test" {
+  name = "%s"
+}
+
+resource "cosmo_namespace_graph_pruning_config" "test" {
+  namespace = cosmo_namespace.test.name
+  enabled   = %t
+  rules = {
+    UNUSED_FIELDS = {
+      severity                          = "%s"
+      grace_period_in_days              = %d
+      schema_usage_check_period_in_days = 7
+    }
+    REQUIRE_DEPRECATION_BEFORE_DELETION = {
+      severity             = "error"
+      grace_period_in_days = 7
+    }
+  }
+}
+`, namespace, enabled, severity, gracePeriod)
+}
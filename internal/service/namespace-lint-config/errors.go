@@ -0,0 +1,8 @@
+package namespace_lint_config
+
+const (
+	ErrConfiguringLinting       = "Error Configuring Namespace Linting"
+	ErrReadingLintConfig        = "Error Reading Namespace Lint Config"
+	ErrResettingLintConfig      = "Error Resetting Namespace Lint Config"
+	ErrUnexpectedDataSourceType = "Unexpected Data Source Configure Type"
+)
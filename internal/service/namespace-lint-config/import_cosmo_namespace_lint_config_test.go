@@ -0,0 +1,30 @@
+package namespace_lint_config_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+func TestAccCosmoNamespaceLintConfigImportBasic(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNamespaceLintConfigResourceConfig(namespace, true, "warn"),
+			},
+			{
+				// import via namespace name
+				ResourceName:      "cosmo_namespace_lint_config.test",
+				ImportState:       true,
+				ImportStateId:     namespace,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,273 @@
+package namespace_lint_config
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
+)
+
+var (
+	_ resource.Resource                = (*NamespaceLintConfigResource)(nil)
+	_ resource.ResourceWithImportState = (*NamespaceLintConfigResource)(nil)
+)
+
+type NamespaceLintConfigResource struct {
+	client *api.PlatformClient
+}
+
+type NamespaceLintConfigResourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	Namespace types.String `tfsdk:"namespace"`
+	Enabled   types.Bool   `tfsdk:"enabled"`
+	Rules     types.Map    `tfsdk:"rules"`
+}
+
+func NewNamespaceLintConfigResource() resource.Resource {
+	return &NamespaceLintConfigResource{}
+}
+
+func (r *NamespaceLintConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_namespace_lint_config"
+}
+
+func (r *NamespaceLintConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Configures schema linting of a namespace. The linter runs on every schema check and reports violations of the configured rules as warnings or errors.
+
+The rules are only applied while linting is enabled. Disabling linting removes the rules from the namespace, they are applied again once it is enabled.
+
+Destroying the resource disables linting and removes all rules of the namespace.
+
+For more information on linting, please refer to the [Cosmo Documentation](https://cosmo-docs.wundergraph.com/studio/lint-policy).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the lint config, which is the name of its namespace.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the namespace to configure linting for.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether linting is enabled for the namespace.",
+			},
+			"rules": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: fmt.Sprintf("The lint rules to apply, mapping the rule name, e.g. `FIELD_NAMES_SHOULD_BE_CAMEL_CASE`, to its severity: `%s` or `%s`.", api.LintSeverityWarn, api.LintSeverityError),
+				Validators: []validator.Map{
+					mapvalidator.ValueStringsAre(
+						stringvalidator.OneOf(api.LintSeverityWarn, api.LintSeverityError),
+					),
+				},
+			},
+		},
+	}
+}
+
+func (r *NamespaceLintConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.PlatformClient)
+	if !ok {
+		utils.AddDiagnosticError(resp, ErrUnexpectedDataSourceType, fmt.Sprintf("Expected *api.PlatformClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NamespaceLintConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NamespaceLintConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.LogAction(ctx, "created", data.Id.ValueString(), "lint config", data.Namespace.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NamespaceLintConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NamespaceLintConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, apiError := r.client.GetNamespaceLintConfig(ctx, data.Namespace.ValueString())
+	if apiError != nil {
+		if api.IsNotFoundError(apiError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddDiagnosticError(resp, ErrReadingLintConfig, apiError.Error())
+		return
+	}
+
+	data.Id = types.StringValue(data.Namespace.ValueString())
+	data.Enabled = types.BoolValue(config.LinterEnabled)
+	// The rules are not applied while linting is disabled, the ones of the
+	// configuration are kept until it is enabled again.
+	if config.LinterEnabled {
+		data.Rules = mapRules(config.Configs, data.Rules, &resp.Diagnostics)
+	}
+
+	utils.LogAction(ctx, "read", data.Id.ValueString(), "lint config", data.Namespace.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NamespaceLintConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NamespaceLintConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.LogAction(ctx, "updated", data.Id.ValueString(), "lint config", data.Namespace.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NamespaceLintConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NamespaceLintConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiError := r.disable(ctx, data.Namespace.ValueString())
+	if apiError != nil {
+		if api.IsNotFoundError(apiError) {
+			return
+		}
+		utils.AddDiagnosticError(resp, ErrResettingLintConfig, apiError.Error())
+		return
+	}
+
+	utils.LogAction(ctx, "deleted", data.Id.ValueString(), "lint config", data.Namespace.ValueString())
+}
+
+func (r *NamespaceLintConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), req.ID)...)
+}
+
+// apply enables linting and replaces the rules of the namespace with the
+// configured ones, or disables it.
+func (r *NamespaceLintConfigResource) apply(ctx context.Context, data *NamespaceLintConfigResourceModel, diags *diag.Diagnostics) {
+	var rules map[string]string
+	if !data.Rules.IsNull() {
+		diags.Append(data.Rules.ElementsAs(ctx, &rules, false)...)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	configs := make([]*platformv1.LintConfig, 0, len(rules))
+	for rule, severity := range rules {
+		configs = append(configs, &platformv1.LintConfig{
+			RuleName:      rule,
+			SeverityLevel: api.ResolveLintSeverity(severity),
+		})
+	}
+
+	namespace := data.Namespace.ValueString()
+	data.Id = types.StringValue(namespace)
+
+	if !data.Enabled.ValueBool() {
+		if apiError := r.disable(ctx, namespace); apiError != nil {
+			diags.AddError(ErrConfiguringLinting, apiError.Error())
+		}
+		return
+	}
+
+	if apiError := r.client.EnableNamespaceLinting(ctx, namespace, true); apiError != nil {
+		diags.AddError(ErrConfiguringLinting, apiError.Error())
+		return
+	}
+	if apiError := r.client.ConfigureNamespaceLintRules(ctx, namespace, configs); apiError != nil {
+		diags.AddError(ErrConfiguringLinting, apiError.Error())
+		return
+	}
+}
+
+// disable removes the rules of the namespace while linting is still enabled
+// and disables it afterwards. Rules are never configured on a namespace with
+// linting disabled.
+func (r *NamespaceLintConfigResource) disable(ctx context.Context, namespace string) *api.ApiError {
+	config, apiError := r.client.GetNamespaceLintConfig(ctx, namespace)
+	if apiError != nil {
+		return apiError
+	}
+
+	if config.LinterEnabled && len(config.Configs) > 0 {
+		if apiError := r.client.ConfigureNamespaceLintRules(ctx, namespace, nil); apiError != nil {
+			return apiError
+		}
+	}
+
+	return r.client.EnableNamespaceLinting(ctx, namespace, false)
+}
+
+// mapRules converts the rules of the platform into the map of the resource,
+// leaving an unset map null while the namespace has no rules.
+func mapRules(configs []*platformv1.LintConfig, current types.Map, diags *diag.Diagnostics) types.Map {
+	if len(configs) == 0 && current.IsNull() {
+		return types.MapNull(types.StringType)
+	}
+
+	rules := make(map[string]attr.Value, len(configs))
+	for _, config := range configs {
+		rules[config.RuleName] = types.StringValue(api.LintSeverityString(config.SeverityLevel))
+	}
+
+	value, d := types.MapValue(types.StringType, rules)
+	diags.Append(d...)
+
+	return value
+}
@@ -0,0 +1,91 @@
+package namespace_lint_config_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+func TestAccNamespaceLintConfigResource(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNamespaceLintConfigResourceConfig(namespace, true, "warn"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_namespace_lint_config.test", "id", namespace),
+					resource.TestCheckResourceAttr("cosmo_namespace_lint_config.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("cosmo_namespace_lint_config.test", "rules.%", "2"),
+					resource.TestCheckResourceAttr("cosmo_namespace_lint_config.test", "rules.FIELD_NAMES_SHOULD_BE_CAMEL_CASE", "warn"),
+				),
+			},
+			{
+				ResourceName: "cosmo_namespace_lint_config.test",
+				RefreshState: true,
+			},
+			{
+				Config: testAccNamespaceLintConfigResourceConfig(namespace, false, "error"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_namespace_lint_config.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("cosmo_namespace_lint_config.test", "rules.FIELD_NAMES_SHOULD_BE_CAMEL_CASE", "error"),
+				),
+			},
+			{
+				// the rules of a disabled namespace are kept in state
+				ResourceName: "cosmo_namespace_lint_config.test",
+				RefreshState: true,
+			},
+			{
+				Config: testAccNamespaceLintConfigResourceConfig(namespace, true, "error"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_namespace_lint_config.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("cosmo_namespace_lint_config.test", "rules.FIELD_NAMES_SHOULD_BE_CAMEL_CASE", "error"),
+				),
+			},
+			{
+				Config:  testAccNamespaceLintConfigResourceConfig(namespace, true, "error"),
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func TestAccNamespaceLintConfigResourceInvalidSeverity(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNamespaceLintConfigResourceConfig(namespace, true, "fatal"),
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+		},
+	})
+}
+
+func testAccNamespaceLintConfigResourceConfig(namespace string, enabled bool, severity string) string {
+	return fmt.Sprintf(`
+resource "cosmo_namespace" "test" {
+  name = "%s"
+}
+
+resource "cosmo_namespace_lint_config" "test" {
+  namespace = cosmo_namespace.test.name
+  enabled   = %t
+  rules = {
+    FIELD_NAMES_SHOULD_BE_CAMEL_CASE = "%s"
+    REQUIRE_DEPRECATION_REASON       = "error"
+  }
+}
+`, namespace, enabled, severity)
+}
@@ -0,0 +1,9 @@
+package oidc_provider
+
+const (
+	ErrCreatingOIDCProvider     = "Error Creating OIDC Provider"
+	ErrReadingOIDCProvider      = "Error Reading OIDC Provider"
+	ErrUpdatingOIDCProvider     = "Error Updating OIDC Provider"
+	ErrDeletingOIDCProvider     = "Error Deleting OIDC Provider"
+	ErrUnexpectedDataSourceType = "Unexpected Data Source Configure Type"
+)
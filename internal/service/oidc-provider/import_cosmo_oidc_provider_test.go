@@ -0,0 +1,50 @@
+package oidc_provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+func TestAccCosmoOIDCProviderImportBasic(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-oidc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOIDCProviderResourceConfig(name, "developer"),
+			},
+			{
+				// import via name, the client credentials are not returned by the platform
+				ResourceName:            "cosmo_oidc_provider.test",
+				ImportState:             true,
+				ImportStateId:           name,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"client_id", "client_secret"},
+			},
+			{
+				// the imported provider takes over the credentials without being recreated
+				ResourceName:       "cosmo_oidc_provider.test",
+				ImportState:        true,
+				ImportStateId:      name,
+				ImportStatePersist: true,
+			},
+			{
+				Config: testAccOIDCProviderResourceConfig(name, "developer"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("cosmo_oidc_provider.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_oidc_provider.test", "client_id", "studio"),
+				),
+			},
+		},
+	})
+}
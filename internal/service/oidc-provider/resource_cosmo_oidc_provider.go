@@ -0,0 +1,389 @@
+package oidc_provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
+)
+
+var (
+	_ resource.Resource                = (*OIDCProviderResource)(nil)
+	_ resource.ResourceWithImportState = (*OIDCProviderResource)(nil)
+)
+
+type OIDCProviderResource struct {
+	client *api.PlatformClient
+}
+
+type OIDCProviderResourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	DiscoveryEndpoint  types.String `tfsdk:"discovery_endpoint"`
+	ClientId           types.String `tfsdk:"client_id"`
+	ClientSecret       types.String `tfsdk:"client_secret"`
+	Mappers            types.Set    `tfsdk:"mappers"`
+	LoginURL           types.String `tfsdk:"login_url"`
+	SignInRedirectURL  types.String `tfsdk:"sign_in_redirect_url"`
+	SignOutRedirectURL types.String `tfsdk:"sign_out_redirect_url"`
+}
+
+type GroupMapperModel struct {
+	Role     types.String `tfsdk:"role"`
+	SsoGroup types.String `tfsdk:"sso_group"`
+}
+
+var groupMapperType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"role":      types.StringType,
+		"sso_group": types.StringType,
+	},
+}
+
+func NewOIDCProviderResource() resource.Resource {
+	return &OIDCProviderResource{}
+}
+
+func (r *OIDCProviderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oidc_provider"
+}
+
+func (r *OIDCProviderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	urlPlanModifiers := []planmodifier.String{
+		stringplanmodifier.UseStateForUnknown(),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Connects an OIDC identity provider to the organization for single sign-on. An organization has at most one OIDC provider.
+
+The client secret is sent to the platform on creation and never read back, changes to the connection itself recreate the provider. The group mappers can be changed in place. After an import, the configured client credentials are taken over into the state on the next apply without recreating the provider.
+
+~> The client secret is stored in plain text in the Terraform state. Protect the state accordingly, e.g. with an encrypted remote backend.
+
+For more information on SSO, please refer to the [Cosmo Documentation](https://cosmo-docs.wundergraph.com/studio/sso).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the OIDC provider, which is its name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the OIDC provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"discovery_endpoint": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The discovery endpoint of the identity provider, ending in `/.well-known/openid-configuration`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceIfOtherEndpoint,
+						"Changing the discovery endpoint recreates the OIDC provider.",
+						"Changing the discovery endpoint recreates the OIDC provider.",
+					),
+				},
+			},
+			"client_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The client id of the application registered with the identity provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceUnlessImported,
+						"Changing the client id recreates the OIDC provider.",
+						"Changing the client id recreates the OIDC provider.",
+					),
+				},
+			},
+			"client_secret": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The client secret of the application registered with the identity provider. It is only sent to the platform when the provider is created, but stored in the Terraform state.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceUnlessImported,
+						"Changing the client secret recreates the OIDC provider.",
+						"Changing the client secret recreates the OIDC provider.",
+					),
+				},
+			},
+			"mappers": schema.SetNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Maps groups of the identity provider to roles in the organization.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: fmt.Sprintf("The role granted to members of the group: `%s`, `%s` or `%s`.", api.OrganizationRoleAdmin, api.OrganizationRoleDeveloper, api.OrganizationRoleViewer),
+							Validators: []validator.String{
+								stringvalidator.OneOf(api.OrganizationRoleAdmin, api.OrganizationRoleDeveloper, api.OrganizationRoleViewer),
+							},
+						},
+						"sso_group": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The name of the group in the identity provider.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"login_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The URL members of the organization sign in with.",
+				PlanModifiers:       urlPlanModifiers,
+			},
+			"sign_in_redirect_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The sign-in redirect URL to register with the identity provider.",
+				PlanModifiers:       urlPlanModifiers,
+			},
+			"sign_out_redirect_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The sign-out redirect URL to register with the identity provider.",
+				PlanModifiers:       urlPlanModifiers,
+			},
+		},
+	}
+}
+
+func (r *OIDCProviderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.PlatformClient)
+	if !ok {
+		utils.AddDiagnosticError(resp, ErrUnexpectedDataSourceType, fmt.Sprintf("Expected *api.PlatformClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OIDCProviderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OIDCProviderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mappers := toGroupMappers(ctx, data.Mappers, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, apiError := r.client.CreateOIDCProvider(ctx, &platformv1.CreateOIDCProviderRequest{
+		Name:              data.Name.ValueString(),
+		DiscoveryEndpoint: data.DiscoveryEndpoint.ValueString(),
+		ClientID:          data.ClientId.ValueString(),
+		ClientSecrect:     data.ClientSecret.ValueString(),
+		Mappers:           mappers,
+	})
+	if apiError != nil {
+		utils.AddDiagnosticError(resp, ErrCreatingOIDCProvider, apiError.Error())
+		return
+	}
+
+	provider, apiError := r.client.GetOIDCProvider(ctx)
+	if apiError != nil {
+		utils.AddDiagnosticError(resp, ErrReadingOIDCProvider, apiError.Error())
+		return
+	}
+
+	setComputedAttributes(provider, &data)
+
+	utils.LogAction(ctx, "created", data.Id.ValueString(), data.Name.ValueString(), "")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OIDCProviderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OIDCProviderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	provider, apiError := r.client.GetOIDCProvider(ctx)
+	if apiError != nil {
+		if api.IsNotFoundError(apiError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddDiagnosticError(resp, ErrReadingOIDCProvider, apiError.Error())
+		return
+	}
+
+	data.Name = types.StringValue(provider.Name)
+	data.DiscoveryEndpoint = mapDiscoveryEndpoint(provider.Endpoint, data.DiscoveryEndpoint)
+	data.Mappers = mapGroupMappers(ctx, provider.Mappers, data.Mappers, &resp.Diagnostics)
+	setComputedAttributes(provider, &data)
+
+	utils.LogAction(ctx, "read", data.Id.ValueString(), data.Name.ValueString(), "")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OIDCProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data OIDCProviderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Everything but the mappers requires replacement.
+	mappers := toGroupMappers(ctx, data.Mappers, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if apiError := r.client.UpdateIDPMappers(ctx, mappers); apiError != nil {
+		utils.AddDiagnosticError(resp, ErrUpdatingOIDCProvider, apiError.Error())
+		return
+	}
+
+	utils.LogAction(ctx, "updated", data.Id.ValueString(), data.Name.ValueString(), "")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OIDCProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OIDCProviderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if apiError := r.client.DeleteOIDCProvider(ctx); apiError != nil {
+		if api.IsNotFoundError(apiError) {
+			return
+		}
+		utils.AddDiagnosticError(resp, ErrDeletingOIDCProvider, apiError.Error())
+		return
+	}
+
+	utils.LogAction(ctx, "deleted", data.Id.ValueString(), data.Name.ValueString(), "")
+}
+
+// ImportState imports the OIDC provider of the organization by its name. The
+// discovery endpoint is read back by Read, the client credentials are not
+// returned by the platform and are taken over from the configuration on the
+// next apply.
+func (r *OIDCProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+}
+
+func setComputedAttributes(provider *platformv1.GetOIDCProviderResponse, data *OIDCProviderResourceModel) {
+	data.Id = types.StringValue(provider.Name)
+	data.LoginURL = types.StringValue(provider.LoginURL)
+	data.SignInRedirectURL = types.StringValue(provider.SignInRedirectURL)
+	data.SignOutRedirectURL = types.StringValue(provider.SignOutRedirectURL)
+}
+
+// requiresReplaceUnlessImported recreates the provider when a client
+// credential changes, except when it is missing from the state because the
+// provider was imported.
+func requiresReplaceUnlessImported(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull()
+}
+
+// requiresReplaceIfOtherEndpoint recreates the provider when the discovery
+// endpoint changes. The platform may only return the host of the endpoint,
+// which an imported state then holds, so a configured endpoint on the same
+// host is not a change.
+func requiresReplaceIfOtherEndpoint(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull() && !sameEndpoint(req.PlanValue.ValueString(), req.StateValue.ValueString())
+}
+
+// mapDiscoveryEndpoint keeps the discovery endpoint of the state as long as it
+// matches the endpoint returned by the platform.
+func mapDiscoveryEndpoint(endpoint string, current types.String) types.String {
+	if endpoint == "" || (!current.IsNull() && sameEndpoint(current.ValueString(), endpoint)) {
+		return current
+	}
+
+	return types.StringValue(endpoint)
+}
+
+// sameEndpoint reports whether the discovery endpoint matches the endpoint
+// returned by the platform, which is either the full URL or its host.
+func sameEndpoint(discoveryEndpoint, endpoint string) bool {
+	if discoveryEndpoint == endpoint {
+		return true
+	}
+
+	u, err := url.Parse(discoveryEndpoint)
+	if err != nil {
+		return false
+	}
+
+	return u.Host == endpoint || u.Hostname() == endpoint
+}
+
+func toGroupMappers(ctx context.Context, set types.Set, diags *diag.Diagnostics) []*platformv1.GroupMapper {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+
+	var models []GroupMapperModel
+	diags.Append(set.ElementsAs(ctx, &models, false)...)
+
+	mappers := make([]*platformv1.GroupMapper, 0, len(models))
+	for _, model := range models {
+		mappers = append(mappers, &platformv1.GroupMapper{
+			Role:     model.Role.ValueString(),
+			SsoGroup: model.SsoGroup.ValueString(),
+		})
+	}
+
+	return mappers
+}
+
+// mapGroupMappers converts the mappers of the platform into the set of the
+// resource, leaving an unset set null while the provider has no mappers.
+func mapGroupMappers(ctx context.Context, mappers []*platformv1.GroupMapper, current types.Set, diags *diag.Diagnostics) types.Set {
+	if len(mappers) == 0 && current.IsNull() {
+		return types.SetNull(groupMapperType)
+	}
+
+	models := make([]GroupMapperModel, 0, len(mappers))
+	for _, mapper := range mappers {
+		models = append(models, GroupMapperModel{
+			Role:     types.StringValue(mapper.Role),
+			SsoGroup: types.StringValue(mapper.SsoGroup),
+		})
+	}
+
+	value, d := types.SetValueFrom(ctx, groupMapperType, models)
+	diags.Append(d...)
+
+	return value
+}
@@ -0,0 +1,115 @@
+package oidc_provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+// discoveryEndpoint is the Keycloak realm of the local development setup of
+// the platform.
+const discoveryEndpoint = "http://localhost:8080/realms/cosmo/.well-known/openid-configuration"
+
+func TestAccOIDCProviderResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-oidc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOIDCProviderResourceConfig(name, "developer"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_oidc_provider.test", "id", name),
+					resource.TestCheckResourceAttr("cosmo_oidc_provider.test", "mappers.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("cosmo_oidc_provider.test", "mappers.*", map[string]string{
+						"role":      "developer",
+						"sso_group": "engineering",
+					}),
+					resource.TestCheckResourceAttrSet("cosmo_oidc_provider.test", "login_url"),
+					resource.TestCheckResourceAttrSet("cosmo_oidc_provider.test", "sign_in_redirect_url"),
+					resource.TestCheckResourceAttrSet("cosmo_oidc_provider.test", "sign_out_redirect_url"),
+				),
+			},
+			{
+				ResourceName: "cosmo_oidc_provider.test",
+				RefreshState: true,
+			},
+			{
+				Config: testAccOIDCProviderResourceConfig(name, "viewer"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("cosmo_oidc_provider.test", "mappers.*", map[string]string{
+						"role":      "viewer",
+						"sso_group": "engineering",
+					}),
+				),
+			},
+			{
+				Config:  testAccOIDCProviderResourceConfig(name, "viewer"),
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func TestAccOIDCProviderResourceInvalidRole(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-oidc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccOIDCProviderResourceConfig(name, "owner"),
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+		},
+	})
+}
+
+func TestAccOIDCProviderResourceEmptyClientSecret(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "cosmo_oidc_provider" "test" {
+  name               = "%s"
+  discovery_endpoint = "%s"
+  client_id          = "studio"
+  client_secret      = ""
+}
+`, acctest.RandomWithPrefix("test-oidc"), discoveryEndpoint),
+				ExpectError: regexp.MustCompile(`string length must be at least 1`),
+			},
+		},
+	})
+}
+
+func testAccOIDCProviderResourceConfig(name, role string) string {
+	return fmt.Sprintf(`
+resource "cosmo_oidc_provider" "test" {
+  name               = "%s"
+  discovery_endpoint = "%s"
+  client_id          = "studio"
+  client_secret      = "secret"
+
+  mappers = [
+    {
+      role      = "admin"
+      sso_group = "platform"
+    },
+    {
+      role      = "%s"
+      sso_group = "engineering"
+    },
+  ]
+}
+`, name, discoveryEndpoint, role)
+}
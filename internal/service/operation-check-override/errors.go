@@ -0,0 +1,11 @@
+package operation_check_override
+
+const (
+	ErrCreatingOverride         = "Error Creating Operation Check Override"
+	ErrReadingOverride          = "Error Reading Operation Check Override"
+	ErrUpdatingOverride         = "Error Updating Operation Check Override"
+	ErrRemovingOverride         = "Error Removing Operation Check Override"
+	ErrInvalidOverride          = "Invalid Operation Check Override"
+	ErrInvalidImportId          = "Invalid Import ID"
+	ErrUnexpectedDataSourceType = "Unexpected Data Source Configure Type"
+)
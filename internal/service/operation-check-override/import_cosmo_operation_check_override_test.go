@@ -0,0 +1,33 @@
+package operation_check_override_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+func TestAccCosmoOperationCheckOverrideImportBasic(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	hash := acctest.RandStringFromCharSet(64, "0123456789abcdef")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOperationCheckOverrideResourceConfig(namespace, hash, `ignore_all = true`),
+			},
+			{
+				// import via namespace, graph name and operation hash
+				ResourceName:            "cosmo_operation_check_override.test",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s/federated-graph/%s", namespace, hash),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"operation_name"},
+			},
+		},
+	})
+}
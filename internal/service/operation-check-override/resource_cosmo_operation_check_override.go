@@ -0,0 +1,397 @@
+package operation_check_override
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
+)
+
+var (
+	_ resource.Resource                   = (*OperationCheckOverrideResource)(nil)
+	_ resource.ResourceWithImportState    = (*OperationCheckOverrideResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*OperationCheckOverrideResource)(nil)
+)
+
+type OperationCheckOverrideResource struct {
+	client *api.PlatformClient
+}
+
+type OperationCheckOverrideResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	GraphName     types.String `tfsdk:"graph_name"`
+	Namespace     types.String `tfsdk:"namespace"`
+	OperationHash types.String `tfsdk:"operation_hash"`
+	OperationName types.String `tfsdk:"operation_name"`
+	IgnoreAll     types.Bool   `tfsdk:"ignore_all"`
+	Changes       types.Set    `tfsdk:"changes"`
+}
+
+type OverrideChangeModel struct {
+	ChangeType types.String `tfsdk:"change_type"`
+	Path       types.String `tfsdk:"path"`
+}
+
+var overrideChangeType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"change_type": types.StringType,
+		"path":        types.StringType,
+	},
+}
+
+func NewOperationCheckOverrideResource() resource.Resource {
+	return &OperationCheckOverrideResource{}
+}
+
+func (r *OperationCheckOverrideResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_operation_check_override"
+}
+
+func (r *OperationCheckOverrideResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Marks breaking changes as safe for an operation of a federated graph, so that schema checks no longer fail when the changes affect the operation. Either all changes are ignored for the operation, or only the listed ones.
+
+Destroying the resource removes the overrides of the operation.
+
+For more information on overrides, please refer to the [Cosmo Documentation](https://cosmo-docs.wundergraph.com/studio/schema-checks#overrides).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the override, `<namespace>/<graph name>/<operation hash>` as used for importing it.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the federated graph the operation belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("default"),
+				MarkdownDescription: "The namespace of the federated graph.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"operation_hash": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The hash of the operation as shown in the schema check.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"operation_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The name of the operation, shown in Studio. It is not returned by the platform.",
+			},
+			"ignore_all": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether all breaking changes are ignored for the operation.",
+			},
+			"changes": schema.SetNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "The breaking changes which are ignored for the operation.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"change_type": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The type of the change, e.g. `FIELD_REMOVED` or `FIELD_TYPE_CHANGED`.",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Z][A-Z_]*$`), "must be a change type in upper snake case, e.g. FIELD_REMOVED"),
+							},
+						},
+						"path": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The schema coordinate the change applies to, e.g. `Query.employees`.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *OperationCheckOverrideResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.PlatformClient)
+	if !ok {
+		utils.AddDiagnosticError(resp, ErrUnexpectedDataSourceType, fmt.Sprintf("Expected *api.PlatformClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OperationCheckOverrideResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data OperationCheckOverrideResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.IgnoreAll.IsUnknown() || data.Changes.IsUnknown() {
+		return
+	}
+
+	if !data.IgnoreAll.ValueBool() && (data.Changes.IsNull() || len(data.Changes.Elements()) == 0) {
+		resp.Diagnostics.AddError(ErrInvalidOverride, "Either 'ignore_all' must be true or 'changes' must be configured.")
+	}
+}
+
+func (r *OperationCheckOverrideResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OperationCheckOverrideResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changes := toOverrideChanges(ctx, data.Changes, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	graphName, namespace, hash := data.GraphName.ValueString(), data.Namespace.ValueString(), data.OperationHash.ValueString()
+	if len(changes) > 0 {
+		if apiError := r.client.CreateOperationOverrides(ctx, graphName, namespace, hash, data.OperationName.ValueString(), changes); apiError != nil {
+			utils.AddDiagnosticError(resp, ErrCreatingOverride, apiError.Error())
+			return
+		}
+	}
+	if data.IgnoreAll.ValueBool() {
+		if apiError := r.client.CreateOperationIgnoreAllOverride(ctx, graphName, namespace, hash, data.OperationName.ValueString()); apiError != nil {
+			utils.AddDiagnosticError(resp, ErrCreatingOverride, apiError.Error())
+			return
+		}
+	}
+
+	data.Id = types.StringValue(overrideId(namespace, graphName, hash))
+
+	utils.LogAction(ctx, "created", data.Id.ValueString(), graphName, namespace)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OperationCheckOverrideResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OperationCheckOverrideResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	graphName, namespace, hash := data.GraphName.ValueString(), data.Namespace.ValueString(), data.OperationHash.ValueString()
+	overrides, apiError := r.client.GetOperationOverrides(ctx, graphName, namespace, hash)
+	if apiError != nil {
+		if api.IsNotFoundError(apiError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddDiagnosticError(resp, ErrReadingOverride, apiError.Error())
+		return
+	}
+
+	// The configuration always overrides something, so no overrides means
+	// they were removed outside of Terraform.
+	if !overrides.IgnoreAll && len(overrides.Changes) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Id = types.StringValue(overrideId(namespace, graphName, hash))
+	data.IgnoreAll = types.BoolValue(overrides.IgnoreAll)
+	data.Changes = mapOverrideChanges(ctx, overrides.Changes, &resp.Diagnostics)
+
+	utils.LogAction(ctx, "read", data.Id.ValueString(), graphName, namespace)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OperationCheckOverrideResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state OperationCheckOverrideResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned := toOverrideChanges(ctx, data.Changes, &resp.Diagnostics)
+	current := toOverrideChanges(ctx, state.Changes, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	graphName, namespace, hash := data.GraphName.ValueString(), data.Namespace.ValueString(), data.OperationHash.ValueString()
+	if removed := subtractChanges(current, planned); len(removed) > 0 {
+		if apiError := r.client.RemoveOperationOverrides(ctx, graphName, namespace, hash, removed); apiError != nil {
+			utils.AddDiagnosticError(resp, ErrUpdatingOverride, apiError.Error())
+			return
+		}
+	}
+	if added := subtractChanges(planned, current); len(added) > 0 {
+		if apiError := r.client.CreateOperationOverrides(ctx, graphName, namespace, hash, data.OperationName.ValueString(), added); apiError != nil {
+			utils.AddDiagnosticError(resp, ErrUpdatingOverride, apiError.Error())
+			return
+		}
+	}
+
+	if data.IgnoreAll.ValueBool() != state.IgnoreAll.ValueBool() {
+		var apiError *api.ApiError
+		if data.IgnoreAll.ValueBool() {
+			apiError = r.client.CreateOperationIgnoreAllOverride(ctx, graphName, namespace, hash, data.OperationName.ValueString())
+		} else {
+			apiError = r.client.RemoveOperationIgnoreAllOverride(ctx, graphName, namespace, hash)
+		}
+		if apiError != nil {
+			utils.AddDiagnosticError(resp, ErrUpdatingOverride, apiError.Error())
+			return
+		}
+	}
+
+	utils.LogAction(ctx, "updated", data.Id.ValueString(), graphName, namespace)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OperationCheckOverrideResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OperationCheckOverrideResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changes := toOverrideChanges(ctx, data.Changes, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	graphName, namespace, hash := data.GraphName.ValueString(), data.Namespace.ValueString(), data.OperationHash.ValueString()
+	if len(changes) > 0 {
+		if apiError := r.client.RemoveOperationOverrides(ctx, graphName, namespace, hash, changes); apiError != nil && !api.IsNotFoundError(apiError) {
+			utils.AddDiagnosticError(resp, ErrRemovingOverride, apiError.Error())
+			return
+		}
+	}
+	if data.IgnoreAll.ValueBool() {
+		if apiError := r.client.RemoveOperationIgnoreAllOverride(ctx, graphName, namespace, hash); apiError != nil && !api.IsNotFoundError(apiError) {
+			utils.AddDiagnosticError(resp, ErrRemovingOverride, apiError.Error())
+			return
+		}
+	}
+
+	utils.LogAction(ctx, "deleted", data.Id.ValueString(), graphName, namespace)
+}
+
+// ImportState imports the overrides of an operation by
+// "<namespace>/<graph name>/<operation hash>".
+func (r *OperationCheckOverrideResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		utils.AddDiagnosticError(resp, ErrInvalidImportId, fmt.Sprintf("Expected an import ID of the form <namespace>/<graph name>/<operation hash>, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_name"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("operation_hash"), parts[2])...)
+}
+
+// overrideId returns the identifier of the override, which is also its
+// import ID.
+func overrideId(namespace, graphName, hash string) string {
+	return fmt.Sprintf("%s/%s/%s", namespace, graphName, hash)
+}
+
+func toOverrideChanges(ctx context.Context, set types.Set, diags *diag.Diagnostics) []*platformv1.OverrideChange {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+
+	var models []OverrideChangeModel
+	diags.Append(set.ElementsAs(ctx, &models, false)...)
+
+	changes := make([]*platformv1.OverrideChange, 0, len(models))
+	for _, model := range models {
+		changes = append(changes, &platformv1.OverrideChange{
+			ChangeType: model.ChangeType.ValueString(),
+			Path:       model.Path.ValueStringPointer(),
+		})
+	}
+
+	return changes
+}
+
+// mapOverrideChanges converts the changes of the platform into the set of the
+// resource, which is null if only all changes are ignored.
+func mapOverrideChanges(ctx context.Context, changes []*platformv1.OverrideChange, diags *diag.Diagnostics) types.Set {
+	if len(changes) == 0 {
+		return types.SetNull(overrideChangeType)
+	}
+
+	models := make([]OverrideChangeModel, 0, len(changes))
+	for _, change := range changes {
+		model := OverrideChangeModel{
+			ChangeType: types.StringValue(change.ChangeType),
+			Path:       types.StringNull(),
+		}
+		if change.GetPath() != "" {
+			model.Path = types.StringValue(change.GetPath())
+		}
+		models = append(models, model)
+	}
+
+	value, d := types.SetValueFrom(ctx, overrideChangeType, models)
+	diags.Append(d...)
+
+	return value
+}
+
+// subtractChanges returns the changes of a which are not part of b.
+func subtractChanges(a, b []*platformv1.OverrideChange) []*platformv1.OverrideChange {
+	var result []*platformv1.OverrideChange
+	for _, change := range a {
+		found := slices.ContainsFunc(b, func(other *platformv1.OverrideChange) bool {
+			return change.ChangeType == other.ChangeType && change.GetPath() == other.GetPath()
+		})
+		if !found {
+			result = append(result, change)
+		}
+	}
+
+	return result
+}
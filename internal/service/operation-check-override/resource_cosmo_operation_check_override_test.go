@@ -0,0 +1,115 @@
+package operation_check_override_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+func TestAccOperationCheckOverrideResource(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	hash := acctest.RandStringFromCharSet(64, "0123456789abcdef")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOperationCheckOverrideResourceConfig(namespace, hash, `
+  changes = [
+    {
+      change_type = "FIELD_REMOVED"
+      path        = "Query.employees"
+    },
+  ]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_operation_check_override.test", "id", fmt.Sprintf("%s/federated-graph/%s", namespace, hash)),
+					resource.TestCheckResourceAttr("cosmo_operation_check_override.test", "ignore_all", "false"),
+					resource.TestCheckResourceAttr("cosmo_operation_check_override.test", "changes.#", "1"),
+				),
+			},
+			{
+				ResourceName: "cosmo_operation_check_override.test",
+				RefreshState: true,
+			},
+			{
+				Config: testAccOperationCheckOverrideResourceConfig(namespace, hash, `
+  changes = [
+    {
+      change_type = "FIELD_TYPE_CHANGED"
+      path        = "Employee.id"
+    },
+    {
+      change_type = "FIELD_REMOVED"
+      path        = "Query.employees"
+    },
+  ]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_operation_check_override.test", "changes.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("cosmo_operation_check_override.test", "changes.*", map[string]string{
+						"change_type": "FIELD_TYPE_CHANGED",
+						"path":        "Employee.id",
+					}),
+				),
+			},
+			{
+				Config: testAccOperationCheckOverrideResourceConfig(namespace, hash, `ignore_all = true`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_operation_check_override.test", "ignore_all", "true"),
+					resource.TestCheckNoResourceAttr("cosmo_operation_check_override.test", "changes"),
+				),
+			},
+			{
+				Config:  testAccOperationCheckOverrideResourceConfig(namespace, hash, `ignore_all = true`),
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func TestAccOperationCheckOverrideResourceWithoutOverrides(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	hash := acctest.RandStringFromCharSet(64, "0123456789abcdef")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccOperationCheckOverrideResourceConfig(namespace, hash, ""),
+				ExpectError: regexp.MustCompile(`Either 'ignore_all' must be true or 'changes' must be configured`),
+			},
+			{
+				Config:      testAccOperationCheckOverrideResourceConfig(namespace, hash, `changes = []`),
+				ExpectError: regexp.MustCompile(`Either 'ignore_all' must be true or 'changes' must be configured`),
+			},
+		},
+	})
+}
+
+func testAccOperationCheckOverrideResourceConfig(namespace, hash, overrides string) string {
+	return fmt.Sprintf(`
+resource "cosmo_namespace" "test" {
+  name = "%s"
+}
+
+resource "cosmo_federated_graph" "test" {
+  name        = "federated-graph"
+  namespace   = cosmo_namespace.test.name
+  routing_url = "https://example.com"
+}
+
+resource "cosmo_operation_check_override" "test" {
+  graph_name     = cosmo_federated_graph.test.name
+  namespace      = cosmo_namespace.test.name
+  operation_hash = "%s"
+  operation_name = "Employees"
+  %s
+}
+`, namespace, hash, overrides)
+}
@@ -0,0 +1,10 @@
+package organization_invitation
+
+const (
+	ErrInvitingUser             = "Error Inviting User"
+	ErrReadingInvitation        = "Error Reading Organization Invitation"
+	ErrUpdatingInvitation       = "Error Updating Organization Invitation"
+	ErrRevokingInvitation       = "Error Revoking Organization Invitation"
+	ErrRemovingMember           = "Error Removing Organization Member"
+	ErrUnexpectedDataSourceType = "Unexpected Data Source Configure Type"
+)
@@ -0,0 +1,31 @@
+package organization_invitation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+func TestAccCosmoOrganizationInvitationImportBasic(t *testing.T) {
+	email := fmt.Sprintf("%s@example.com", acctest.RandomWithPrefix("test-invitation"))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationInvitationResourceConfig(email),
+			},
+			{
+				// import via email
+				ResourceName:      "cosmo_organization_invitation.test",
+				ImportState:       true,
+				ImportStateId:     email,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,231 @@
+package organization_invitation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
+)
+
+const (
+	InvitationStatusPending  = "pending"
+	InvitationStatusAccepted = "accepted"
+)
+
+var (
+	_ resource.Resource                = (*OrganizationInvitationResource)(nil)
+	_ resource.ResourceWithImportState = (*OrganizationInvitationResource)(nil)
+)
+
+type OrganizationInvitationResource struct {
+	client *api.PlatformClient
+}
+
+type OrganizationInvitationResourceModel struct {
+	Id     types.String `tfsdk:"id"`
+	Email  types.String `tfsdk:"email"`
+	UserId types.String `tfsdk:"user_id"`
+	Status types.String `tfsdk:"status"`
+}
+
+func NewOrganizationInvitationResource() resource.Resource {
+	return &OrganizationInvitationResource{}
+}
+
+func (r *OrganizationInvitationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_invitation"
+}
+
+func (r *OrganizationInvitationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Invites a user to the organization by email. The invitation stays pending until the user accepts it, the user then becomes a member of the organization. Roles of members are managed with ` + "`cosmo_organization_member_role`" + `.
+
+Destroying the resource revokes a pending invitation, or removes the user from the organization once the invitation was accepted. The user the API key belongs to is never removed. A declined invitation is recreated on the next apply.
+
+For more information on members, please refer to the [Cosmo Documentation](https://cosmo-docs.wundergraph.com/studio/members).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the invitation, which is the email of the invited user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The email of the user to invite.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the invited user.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("The status of the invitation: `%s` until the user accepts it, `%s` afterwards.", InvitationStatusPending, InvitationStatusAccepted),
+			},
+		},
+	}
+}
+
+func (r *OrganizationInvitationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.PlatformClient)
+	if !ok {
+		utils.AddDiagnosticError(resp, ErrUnexpectedDataSourceType, fmt.Sprintf("Expected *api.PlatformClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OrganizationInvitationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OrganizationInvitationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	email := data.Email.ValueString()
+	if apiError := r.client.InviteUser(ctx, email); apiError != nil {
+		utils.AddDiagnosticError(resp, ErrInvitingUser, apiError.Error())
+		return
+	}
+
+	found, apiError := r.refresh(ctx, &data)
+	if apiError != nil {
+		utils.AddDiagnosticError(resp, ErrReadingInvitation, apiError.Error())
+		return
+	}
+	if !found {
+		utils.AddDiagnosticError(resp, ErrReadingInvitation, fmt.Sprintf("The invitation of %s was not found after it was created.", email))
+		return
+	}
+
+	utils.LogAction(ctx, "created", data.Id.ValueString(), email, "")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationInvitationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrganizationInvitationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, apiError := r.refresh(ctx, &data)
+	if apiError != nil {
+		utils.AddDiagnosticError(resp, ErrReadingInvitation, apiError.Error())
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	utils.LogAction(ctx, "read", data.Id.ValueString(), data.Email.ValueString(), "")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationInvitationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	utils.AddDiagnosticError(resp, ErrUpdatingInvitation, "Organization invitation update should never be called, please delete and recreate the invitation")
+}
+
+func (r *OrganizationInvitationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OrganizationInvitationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The status in the state may be outdated, the user could have accepted
+	// the invitation since the last refresh.
+	found, apiError := r.refresh(ctx, &data)
+	if apiError != nil {
+		utils.AddDiagnosticError(resp, ErrReadingInvitation, apiError.Error())
+		return
+	}
+	if !found {
+		return
+	}
+
+	email := data.Email.ValueString()
+	if data.Status.ValueString() == InvitationStatusPending {
+		if apiError := r.client.RemoveInvitation(ctx, email); apiError != nil && !api.IsNotFoundError(apiError) {
+			utils.AddDiagnosticError(resp, ErrRevokingInvitation, apiError.Error())
+			return
+		}
+	} else {
+		if apiError := r.client.RemoveOrganizationMember(ctx, email); apiError != nil {
+			if api.IsRemovingOwnUserError(apiError) {
+				utils.AddDiagnosticError(resp, ErrRemovingMember, fmt.Sprintf("%s is the user of the API key used by the provider and cannot be removed from the organization.", email))
+				return
+			}
+			if !api.IsNotFoundError(apiError) {
+				utils.AddDiagnosticError(resp, ErrRemovingMember, apiError.Error())
+				return
+			}
+		}
+	}
+
+	utils.LogAction(ctx, "deleted", data.Id.ValueString(), email, "")
+}
+
+func (r *OrganizationInvitationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), req.ID)...)
+}
+
+// refresh sets the status of the invitation, looking it up among the pending
+// invitations first and among the members of the organization afterwards. It
+// reports false if the user is neither invited nor a member.
+func (r *OrganizationInvitationResource) refresh(ctx context.Context, data *OrganizationInvitationResourceModel) (bool, *api.ApiError) {
+	email := data.Email.ValueString()
+	data.Id = types.StringValue(email)
+
+	invitation, apiError := r.client.GetPendingInvitation(ctx, email)
+	if apiError == nil {
+		data.UserId = types.StringValue(invitation.UserID)
+		data.Status = types.StringValue(InvitationStatusPending)
+		return true, nil
+	}
+	if !api.IsNotFoundError(apiError) {
+		return false, apiError
+	}
+
+	member, apiError := r.client.GetOrganizationMember(ctx, email)
+	if apiError != nil {
+		if api.IsNotFoundError(apiError) {
+			return false, nil
+		}
+		return false, apiError
+	}
+
+	data.UserId = types.StringValue(member.UserID)
+	data.Status = types.StringValue(InvitationStatusAccepted)
+	return true, nil
+}
@@ -0,0 +1,47 @@
+package organization_invitation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+func TestAccOrganizationInvitationResource(t *testing.T) {
+	email := fmt.Sprintf("%s@example.com", acctest.RandomWithPrefix("test-invitation"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationInvitationResourceConfig(email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_organization_invitation.test", "id", email),
+					resource.TestCheckResourceAttr("cosmo_organization_invitation.test", "email", email),
+					resource.TestCheckResourceAttr("cosmo_organization_invitation.test", "status", "pending"),
+					resource.TestCheckResourceAttrSet("cosmo_organization_invitation.test", "user_id"),
+				),
+			},
+			{
+				ResourceName: "cosmo_organization_invitation.test",
+				RefreshState: true,
+			},
+			{
+				Config:  testAccOrganizationInvitationResourceConfig(email),
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func testAccOrganizationInvitationResourceConfig(email string) string {
+	return fmt.Sprintf(`
+resource "cosmo_organization_invitation" "test" {
+  email = "%s"
+}
+`, email)
+}
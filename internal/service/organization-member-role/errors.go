@@ -0,0 +1,8 @@
+package organization_member_role
+
+const (
+	ErrAssigningRole            = "Error Assigning Organization Role"
+	ErrReadingMemberRole        = "Error Reading Organization Member Role"
+	ErrMemberNotFound           = "Organization Member Not Found"
+	ErrUnexpectedDataSourceType = "Unexpected Data Source Configure Type"
+)
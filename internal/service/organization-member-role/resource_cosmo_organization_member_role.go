@@ -0,0 +1,211 @@
+package organization_member_role
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
+)
+
+var (
+	_ resource.Resource                = (*OrganizationMemberRoleResource)(nil)
+	_ resource.ResourceWithImportState = (*OrganizationMemberRoleResource)(nil)
+)
+
+type OrganizationMemberRoleResource struct {
+	client *api.PlatformClient
+}
+
+type OrganizationMemberRoleResourceModel struct {
+	Id     types.String `tfsdk:"id"`
+	Email  types.String `tfsdk:"email"`
+	Role   types.String `tfsdk:"role"`
+	UserId types.String `tfsdk:"user_id"`
+}
+
+func NewOrganizationMemberRoleResource() resource.Resource {
+	return &OrganizationMemberRoleResource{}
+}
+
+func (r *OrganizationMemberRoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_member_role"
+}
+
+func (r *OrganizationMemberRoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Assigns a role to a member of the organization. The user must have accepted the invitation to the organization, e.g. one created with ` + "`cosmo_organization_invitation`" + `, before a role can be assigned.
+
+A member always has a role, destroying the resource keeps the last assigned role of the member.
+
+For more information on roles, please refer to the [Cosmo Documentation](https://cosmo-docs.wundergraph.com/studio/rbac).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the organization member.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The email of the member.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"role": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: fmt.Sprintf("The role of the member: `%s`, `%s` or `%s`.", api.OrganizationRoleAdmin, api.OrganizationRoleDeveloper, api.OrganizationRoleViewer),
+				Validators: []validator.String{
+					stringvalidator.OneOf(api.OrganizationRoleAdmin, api.OrganizationRoleDeveloper, api.OrganizationRoleViewer),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OrganizationMemberRoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.PlatformClient)
+	if !ok {
+		utils.AddDiagnosticError(resp, ErrUnexpectedDataSourceType, fmt.Sprintf("Expected *api.PlatformClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OrganizationMemberRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OrganizationMemberRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.assign(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.LogAction(ctx, "created", data.Id.ValueString(), data.Email.ValueString(), "")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationMemberRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrganizationMemberRoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, apiError := r.client.GetOrganizationMember(ctx, data.Email.ValueString())
+	if apiError != nil {
+		if api.IsNotFoundError(apiError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddDiagnosticError(resp, ErrReadingMemberRole, apiError.Error())
+		return
+	}
+
+	data.Id = types.StringValue(member.OrgMemberID)
+	data.UserId = types.StringValue(member.UserID)
+	// Keep the configured role as long as the member still has it.
+	if !slices.Contains(member.Roles, data.Role.ValueString()) {
+		if len(member.Roles) > 0 {
+			data.Role = types.StringValue(member.Roles[0])
+		} else {
+			data.Role = types.StringNull()
+		}
+	}
+
+	utils.LogAction(ctx, "read", data.Id.ValueString(), data.Email.ValueString(), "")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationMemberRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data OrganizationMemberRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.assign(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.LogAction(ctx, "updated", data.Id.ValueString(), data.Email.ValueString(), "")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationMemberRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OrganizationMemberRoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Members cannot be without a role, the assigned role is left in place.
+	utils.LogAction(ctx, "deleted", data.Id.ValueString(), data.Email.ValueString(), "")
+}
+
+func (r *OrganizationMemberRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), req.ID)...)
+}
+
+// assign looks up the member by email and assigns the configured role.
+func (r *OrganizationMemberRoleResource) assign(ctx context.Context, data *OrganizationMemberRoleResourceModel, diags *diag.Diagnostics) {
+	email := data.Email.ValueString()
+
+	member, apiError := r.client.GetOrganizationMember(ctx, email)
+	if apiError != nil {
+		if api.IsNotFoundError(apiError) {
+			diags.AddError(ErrMemberNotFound, fmt.Sprintf("%s is not a member of the organization. Roles can only be assigned once the invitation was accepted.", email))
+			return
+		}
+		diags.AddError(ErrReadingMemberRole, apiError.Error())
+		return
+	}
+
+	if apiError := r.client.UpdateOrganizationMemberRole(ctx, member.UserID, data.Role.ValueString()); apiError != nil {
+		diags.AddError(ErrAssigningRole, apiError.Error())
+		return
+	}
+
+	data.Id = types.StringValue(member.OrgMemberID)
+	data.UserId = types.StringValue(member.UserID)
+}
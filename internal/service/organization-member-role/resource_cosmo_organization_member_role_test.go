@@ -0,0 +1,81 @@
+package organization_member_role_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+// memberEmail is the user seeded into the organization of the local
+// development setup of the platform.
+const memberEmail = "foo@wundergraph.com"
+
+func TestAccOrganizationMemberRoleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationMemberRoleResourceConfig(memberEmail, "admin"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_organization_member_role.test", "email", memberEmail),
+					resource.TestCheckResourceAttr("cosmo_organization_member_role.test", "role", "admin"),
+					resource.TestCheckResourceAttrSet("cosmo_organization_member_role.test", "id"),
+					resource.TestCheckResourceAttrSet("cosmo_organization_member_role.test", "user_id"),
+				),
+			},
+			{
+				ResourceName: "cosmo_organization_member_role.test",
+				RefreshState: true,
+			},
+			{
+				ResourceName:      "cosmo_organization_member_role.test",
+				ImportState:       true,
+				ImportStateId:     memberEmail,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccOrganizationMemberRoleResourceInvalidRole(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccOrganizationMemberRoleResourceConfig(memberEmail, "owner"),
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationMemberRoleResourceNotAMember(t *testing.T) {
+	email := fmt.Sprintf("%s@example.com", acctest.RandomWithPrefix("test-member"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccOrganizationMemberRoleResourceConfig(email, "viewer"),
+				ExpectError: regexp.MustCompile(`is not a member of the organization`),
+			},
+		},
+	})
+}
+
+func testAccOrganizationMemberRoleResourceConfig(email, role string) string {
+	return fmt.Sprintf(`
+resource "cosmo_organization_member_role" "test" {
+  email = "%s"
+  role  = "%s"
+}
+`, email, role)
+}
@@ -0,0 +1,10 @@
+package organization_webhook
+
+const (
+	ErrCreatingWebhook          = "Error Creating Organization Webhook"
+	ErrReadingWebhook           = "Error Reading Organization Webhook"
+	ErrUpdatingWebhook          = "Error Updating Organization Webhook"
+	ErrDeletingWebhook          = "Error Deleting Organization Webhook"
+	ErrInvalidWebhookFilter     = "Invalid Organization Webhook Filter"
+	ErrUnexpectedDataSourceType = "Unexpected Data Source Configure Type"
+)
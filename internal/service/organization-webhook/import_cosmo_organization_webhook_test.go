@@ -0,0 +1,30 @@
+package organization_webhook_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+func TestAccCosmoOrganizationWebhookImportBasic(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationWebhookResourceConfig(namespace, "https://example.com/webhook", "secret"),
+			},
+			{
+				// the key is not returned by the platform
+				ResourceName:            "cosmo_organization_webhook.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key"},
+			},
+		},
+	})
+}
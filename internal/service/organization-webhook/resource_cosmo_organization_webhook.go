@@ -0,0 +1,349 @@
+package organization_webhook
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/notifications"
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
+)
+
+var (
+	_ resource.Resource                   = (*OrganizationWebhookResource)(nil)
+	_ resource.ResourceWithImportState    = (*OrganizationWebhookResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*OrganizationWebhookResource)(nil)
+)
+
+type OrganizationWebhookResource struct {
+	client *api.PlatformClient
+}
+
+type OrganizationWebhookResourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	Endpoint          types.String `tfsdk:"endpoint"`
+	Key               types.String `tfsdk:"key"`
+	Events            types.Set    `tfsdk:"events"`
+	FederatedGraphIds types.Set    `tfsdk:"federated_graph_ids"`
+	MonographIds      types.Set    `tfsdk:"monograph_ids"`
+}
+
+func NewOrganizationWebhookResource() resource.Resource {
+	return &OrganizationWebhookResource{}
+}
+
+func (r *OrganizationWebhookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_webhook"
+}
+
+func (r *OrganizationWebhookResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Configures a webhook which the platform calls when events of the organization occur, e.g. when the schema of a federated graph was updated.
+
+For more information on webhooks, please refer to the [Cosmo Documentation](https://cosmo-docs.wundergraph.com/studio/webhooks).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the webhook.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"endpoint": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The URL the events are sent to.",
+			},
+			"key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The secret used to sign the payload of the events with HMAC. The platform does not return the key, therefore changes made to it outside of Terraform are not detected.",
+			},
+			"events": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: fmt.Sprintf("The events the webhook is called for. One of %s or %s.", api.OrganizationWebhookEventFederatedGraphSchemaUpdated, api.OrganizationWebhookEventMonographSchemaUpdated),
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.OneOf(api.OrganizationWebhookEventFederatedGraphSchemaUpdated, api.OrganizationWebhookEventMonographSchemaUpdated),
+					),
+				},
+			},
+			"federated_graph_ids": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: fmt.Sprintf("The ids of the federated graphs the %s event is sent for.", api.OrganizationWebhookEventFederatedGraphSchemaUpdated),
+			},
+			"monograph_ids": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: fmt.Sprintf("The ids of the monographs the %s event is sent for.", api.OrganizationWebhookEventMonographSchemaUpdated),
+			},
+		},
+	}
+}
+
+func (r *OrganizationWebhookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.PlatformClient)
+	if !ok {
+		utils.AddDiagnosticError(resp, ErrUnexpectedDataSourceType, fmt.Sprintf("Expected *api.PlatformClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OrganizationWebhookResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data OrganizationWebhookResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Events.IsUnknown() {
+		return
+	}
+
+	events := setStrings(ctx, data.Events, &resp.Diagnostics)
+	filters := map[string]types.Set{
+		api.OrganizationWebhookEventFederatedGraphSchemaUpdated: data.FederatedGraphIds,
+		api.OrganizationWebhookEventMonographSchemaUpdated:      data.MonographIds,
+	}
+	attributes := map[string]string{
+		api.OrganizationWebhookEventFederatedGraphSchemaUpdated: "federated_graph_ids",
+		api.OrganizationWebhookEventMonographSchemaUpdated:      "monograph_ids",
+	}
+
+	for event, filter := range filters {
+		if filter.IsNull() || filter.IsUnknown() || slices.Contains(events, event) {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(path.Root(attributes[event]), ErrInvalidWebhookFilter,
+			fmt.Sprintf("'%s' only applies to the %s event, which is not part of 'events'.", attributes[event], event))
+	}
+}
+
+func (r *OrganizationWebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OrganizationWebhookResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	events, eventsMeta := toEvents(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, apiError := r.client.CreateOrganizationWebhook(ctx, &platformv1.CreateOrganizationWebhookConfigRequest{
+		Endpoint:   data.Endpoint.ValueString(),
+		Key:        data.Key.ValueString(),
+		Events:     events,
+		EventsMeta: eventsMeta,
+	})
+	if apiError != nil {
+		utils.AddDiagnosticError(resp, ErrCreatingWebhook, apiError.Error())
+		return
+	}
+
+	data.Id = types.StringValue(id)
+
+	utils.LogAction(ctx, "created", data.Id.ValueString(), data.Endpoint.ValueString(), "")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationWebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrganizationWebhookResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhook, apiError := r.client.GetOrganizationWebhook(ctx, data.Id.ValueString())
+	if apiError != nil {
+		if api.IsNotFoundError(apiError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddDiagnosticError(resp, ErrReadingWebhook, apiError.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(mapWebhookToResourceModel(webhook, &data)...)
+
+	utils.LogAction(ctx, "read", data.Id.ValueString(), data.Endpoint.ValueString(), "")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationWebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state OrganizationWebhookResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	events, eventsMeta := toEvents(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiError := r.client.UpdateOrganizationWebhook(ctx, &platformv1.UpdateOrganizationWebhookConfigRequest{
+		Id:              state.Id.ValueString(),
+		Endpoint:        data.Endpoint.ValueString(),
+		Key:             data.Key.ValueString(),
+		Events:          events,
+		EventsMeta:      eventsMeta,
+		ShouldUpdateKey: !data.Key.Equal(state.Key),
+	})
+	if apiError != nil {
+		if api.IsNotFoundError(apiError) {
+			utils.AddDiagnosticWarning(resp, ErrUpdatingWebhook, apiError.Error())
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddDiagnosticError(resp, ErrUpdatingWebhook, apiError.Error())
+		return
+	}
+
+	data.Id = state.Id
+
+	utils.LogAction(ctx, "updated", data.Id.ValueString(), data.Endpoint.ValueString(), "")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationWebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OrganizationWebhookResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiError := r.client.DeleteOrganizationWebhook(ctx, data.Id.ValueString())
+	if apiError != nil {
+		if api.IsNotFoundError(apiError) {
+			return
+		}
+		utils.AddDiagnosticError(resp, ErrDeletingWebhook, apiError.Error())
+		return
+	}
+
+	utils.LogAction(ctx, "deleted", data.Id.ValueString(), data.Endpoint.ValueString(), "")
+}
+
+func (r *OrganizationWebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toEvents converts the configured events and graph filters into the request
+// format of the platform. Filters are only sent along with their event.
+func toEvents(ctx context.Context, data *OrganizationWebhookResourceModel, diags *diag.Diagnostics) ([]string, []*notifications.EventMeta) {
+	events := setStrings(ctx, data.Events, diags)
+
+	eventsMeta := make([]*notifications.EventMeta, 0, len(events))
+	for _, event := range events {
+		switch event {
+		case api.OrganizationWebhookEventFederatedGraphSchemaUpdated:
+			eventsMeta = append(eventsMeta, &notifications.EventMeta{
+				EventName: notifications.OrganizationEventName_FEDERATED_GRAPH_SCHEMA_UPDATED,
+				Meta: &notifications.EventMeta_FederatedGraphSchemaUpdated{
+					FederatedGraphSchemaUpdated: &notifications.GraphSchemaUpdatedMeta{
+						GraphIds: setStrings(ctx, data.FederatedGraphIds, diags),
+					},
+				},
+			})
+		case api.OrganizationWebhookEventMonographSchemaUpdated:
+			eventsMeta = append(eventsMeta, &notifications.EventMeta{
+				EventName: notifications.OrganizationEventName_MONOGRAPH_SCHEMA_UPDATED,
+				Meta: &notifications.EventMeta_MonographSchemaUpdated{
+					MonographSchemaUpdated: &notifications.GraphSchemaUpdatedMeta{
+						GraphIds: setStrings(ctx, data.MonographIds, diags),
+					},
+				},
+			})
+		}
+	}
+
+	return events, eventsMeta
+}
+
+func mapWebhookToResourceModel(webhook *api.OrganizationWebhook, data *OrganizationWebhookResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	events := make([]attr.Value, 0, len(webhook.Events))
+	for _, event := range webhook.Events {
+		events = append(events, types.StringValue(event))
+	}
+	eventSet, d := types.SetValue(types.StringType, events)
+	diags.Append(d...)
+
+	var federatedGraphIds, monographIds []string
+	for _, meta := range webhook.EventsMeta {
+		if m := meta.GetFederatedGraphSchemaUpdated(); m != nil {
+			federatedGraphIds = append(federatedGraphIds, m.GetGraphIds()...)
+		}
+		if m := meta.GetMonographSchemaUpdated(); m != nil {
+			monographIds = append(monographIds, m.GetGraphIds()...)
+		}
+	}
+
+	data.Id = types.StringValue(webhook.Id)
+	data.Endpoint = types.StringValue(webhook.Endpoint)
+	data.Events = eventSet
+	data.FederatedGraphIds = toOptionalSet(federatedGraphIds, data.FederatedGraphIds, &diags)
+	data.MonographIds = toOptionalSet(monographIds, data.MonographIds, &diags)
+
+	return diags
+}
+
+// toOptionalSet keeps an unset filter null as long as the platform has no
+// graphs for it, so an omitted attribute does not show a diff to an empty set.
+func toOptionalSet(values []string, current types.Set, diags *diag.Diagnostics) types.Set {
+	if len(values) == 0 && current.IsNull() {
+		return types.SetNull(types.StringType)
+	}
+
+	elements := make([]attr.Value, 0, len(values))
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+
+	set, d := types.SetValue(types.StringType, elements)
+	diags.Append(d...)
+
+	return set
+}
+
+func setStrings(ctx context.Context, set types.Set, diags *diag.Diagnostics) []string {
+	var values []string
+	if set.IsNull() || set.IsUnknown() {
+		return values
+	}
+
+	diags.Append(set.ElementsAs(ctx, &values, false)...)
+
+	return values
+}
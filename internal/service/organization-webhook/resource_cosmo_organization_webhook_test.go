@@ -0,0 +1,87 @@
+package organization_webhook_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+func TestAccOrganizationWebhookResource(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationWebhookResourceConfig(namespace, "https://example.com/webhook", "secret"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("cosmo_organization_webhook.test", "id"),
+					resource.TestCheckResourceAttr("cosmo_organization_webhook.test", "endpoint", "https://example.com/webhook"),
+					resource.TestCheckResourceAttr("cosmo_organization_webhook.test", "events.#", "1"),
+					resource.TestCheckResourceAttr("cosmo_organization_webhook.test", "federated_graph_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName: "cosmo_organization_webhook.test",
+				RefreshState: true,
+			},
+			{
+				Config: testAccOrganizationWebhookResourceConfig(namespace, "https://example.com/updated", "rotated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_organization_webhook.test", "endpoint", "https://example.com/updated"),
+					resource.TestCheckResourceAttr("cosmo_organization_webhook.test", "key", "rotated"),
+				),
+			},
+			{
+				Config:  testAccOrganizationWebhookResourceConfig(namespace, "https://example.com/updated", "rotated"),
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func TestAccOrganizationWebhookResourceInvalidFilter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "cosmo_organization_webhook" "test" {
+  endpoint      = "https://example.com/webhook"
+  events        = ["FEDERATED_GRAPH_SCHEMA_UPDATED"]
+  monograph_ids = ["00000000-0000-0000-0000-000000000000"]
+}
+`,
+				ExpectError: regexp.MustCompile(`only applies to the MONOGRAPH_SCHEMA_UPDATED event`),
+			},
+		},
+	})
+}
+
+func testAccOrganizationWebhookResourceConfig(namespace, endpoint, key string) string {
+	return fmt.Sprintf(`
+resource "cosmo_namespace" "test" {
+  name = "%s"
+}
+
+resource "cosmo_federated_graph" "test" {
+  name        = "federated-graph"
+  namespace   = cosmo_namespace.test.name
+  routing_url = "https://example.com"
+}
+
+resource "cosmo_organization_webhook" "test" {
+  endpoint            = "%s"
+  key                 = "%s"
+  events              = ["FEDERATED_GRAPH_SCHEMA_UPDATED"]
+  federated_graph_ids = [cosmo_federated_graph.test.id]
+}
+`, namespace, endpoint, key)
+}
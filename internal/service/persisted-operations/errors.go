@@ -0,0 +1,10 @@
+package persisted_operations
+
+const (
+	ErrInvalidManifest          = "Invalid Persisted Operations Manifest"
+	ErrMissingOperations        = "Missing Persisted Operations"
+	ErrPublishingOperations     = "Error Publishing Persisted Operations"
+	ErrReadingOperations        = "Error Reading Persisted Operations"
+	ErrConflictingOperations    = "Conflicting Persisted Operations"
+	ErrUnexpectedDataSourceType = "Unexpected Data Source Configure Type"
+)
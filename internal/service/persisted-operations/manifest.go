@@ -0,0 +1,106 @@
+package persisted_operations
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+)
+
+const apolloManifestFormat = "apollo-persisted-query-manifest"
+
+// apolloManifest is the persisted query manifest generated by
+// @apollo/generate-persisted-query-manifest.
+type apolloManifest struct {
+	Format     string `json:"format"`
+	Version    int    `json:"version"`
+	Operations []struct {
+		Id   string `json:"id"`
+		Body string `json:"body"`
+	} `json:"operations"`
+}
+
+// cosmoOperation is an entry of a Cosmo manifest, which is a list of
+// operations with the fields of the platform's persisted operations.
+type cosmoOperation struct {
+	Id       string `json:"id"`
+	Contents string `json:"contents"`
+}
+
+// documentOperation returns the operation for a GraphQL document. Its id is the
+// SHA-256 of the document, the same wgc uses for documents pushed from files.
+func documentOperation(document string) *platformv1.PersistedOperation {
+	sum := sha256.Sum256([]byte(document))
+	return &platformv1.PersistedOperation{
+		Id:       hex.EncodeToString(sum[:]),
+		Contents: document,
+	}
+}
+
+// parseManifest reads the operations of an Apollo persisted query manifest, a
+// Relay query map ({"<id>": "<document>"}) or a Cosmo manifest
+// ([{"id": "<id>", "contents": "<document>"}]). The format is detected from
+// the structure of the JSON document.
+func parseManifest(manifest string) ([]*platformv1.PersistedOperation, error) {
+	var raw any
+	if err := json.Unmarshal([]byte(manifest), &raw); err != nil {
+		return nil, fmt.Errorf("manifest is not valid JSON: %w", err)
+	}
+
+	switch value := raw.(type) {
+	case []any:
+		var entries []cosmoOperation
+		if err := json.Unmarshal([]byte(manifest), &entries); err != nil {
+			return nil, fmt.Errorf("invalid Cosmo manifest: %w", err)
+		}
+
+		operations := make([]*platformv1.PersistedOperation, 0, len(entries))
+		for i, entry := range entries {
+			if entry.Id == "" || entry.Contents == "" {
+				return nil, fmt.Errorf("invalid Cosmo manifest: operation %d requires an id and contents", i)
+			}
+			operations = append(operations, &platformv1.PersistedOperation{Id: entry.Id, Contents: entry.Contents})
+		}
+		return operations, nil
+
+	case map[string]any:
+		if format, ok := value["format"]; ok {
+			if format != apolloManifestFormat {
+				return nil, fmt.Errorf("unsupported manifest format %v", format)
+			}
+
+			var apollo apolloManifest
+			if err := json.Unmarshal([]byte(manifest), &apollo); err != nil {
+				return nil, fmt.Errorf("invalid Apollo manifest: %w", err)
+			}
+			if apollo.Version != 1 {
+				return nil, fmt.Errorf("unsupported Apollo manifest version %d", apollo.Version)
+			}
+
+			operations := make([]*platformv1.PersistedOperation, 0, len(apollo.Operations))
+			for i, operation := range apollo.Operations {
+				if operation.Id == "" || operation.Body == "" {
+					return nil, fmt.Errorf("invalid Apollo manifest: operation %d requires an id and body", i)
+				}
+				operations = append(operations, &platformv1.PersistedOperation{Id: operation.Id, Contents: operation.Body})
+			}
+			return operations, nil
+		}
+
+		operations := make([]*platformv1.PersistedOperation, 0, len(value))
+		for id, body := range value {
+			document, ok := body.(string)
+			if !ok || document == "" {
+				return nil, fmt.Errorf("invalid Relay manifest: operation %s must be a GraphQL document", id)
+			}
+			operations = append(operations, &platformv1.PersistedOperation{Id: id, Contents: document})
+		}
+		return operations, nil
+
+	default:
+		return nil, errors.New("manifest must be an Apollo persisted query manifest, a Relay query map or a list of Cosmo operations")
+	}
+}
@@ -0,0 +1,106 @@
+package persisted_operations
+
+import (
+	"os"
+	"sort"
+	"testing"
+)
+
+func TestParseManifest(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest string
+		ids      []string
+	}{
+		{
+			name:     "apollo",
+			manifest: `{"format":"apollo-persisted-query-manifest","version":1,"operations":[{"id":"a1","name":"Employees","type":"query","body":"query Employees { employees { id } }"}]}`,
+			ids:      []string{"a1"},
+		},
+		{
+			name:     "relay",
+			manifest: `{"r1":"query A { a }","r2":"query B { b }"}`,
+			ids:      []string{"r1", "r2"},
+		},
+		{
+			name:     "cosmo",
+			manifest: `[{"id":"c1","contents":"query C { c }"}]`,
+			ids:      []string{"c1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			operations, err := parseManifest(tt.manifest)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			ids := make([]string, 0, len(operations))
+			for _, operation := range operations {
+				if operation.Contents == "" {
+					t.Errorf("operation %s has no contents", operation.Id)
+				}
+				ids = append(ids, operation.Id)
+			}
+			sort.Strings(ids)
+
+			if len(ids) != len(tt.ids) {
+				t.Fatalf("expected operations %v, got %v", tt.ids, ids)
+			}
+			for i := range ids {
+				if ids[i] != tt.ids[i] {
+					t.Fatalf("expected operations %v, got %v", tt.ids, ids)
+				}
+			}
+		})
+	}
+}
+
+func TestParseManifestInvalid(t *testing.T) {
+	manifests := map[string]string{
+		"not json":               `query A { a }`,
+		"scalar":                 `"query A { a }"`,
+		"unknown format":         `{"format":"other","operations":[]}`,
+		"apollo version":         `{"format":"apollo-persisted-query-manifest","version":2,"operations":[]}`,
+		"apollo without body":    `{"format":"apollo-persisted-query-manifest","version":1,"operations":[{"id":"a1"}]}`,
+		"relay without document": `{"r1":42}`,
+		"cosmo without id":       `[{"contents":"query C { c }"}]`,
+	}
+
+	for name, manifest := range manifests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := parseManifest(manifest); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+func TestDocumentOperation(t *testing.T) {
+	operation := documentOperation("query { hello }")
+
+	// sha256 of the document, as computed by wgc
+	if operation.Id != "ec2e01311ab3b02f3d8c8c712f9e579356d332cd007ac4c1ea5df727f482f05f" {
+		t.Fatalf("unexpected id %s", operation.Id)
+	}
+}
+
+func TestExampleManifest(t *testing.T) {
+	manifest, err := os.ReadFile("../../../examples/resources/cosmo_persisted_operations/persisted-query-manifest.json")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	operations, err := parseManifest(string(manifest))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Apollo operation ids are the sha256 of their body.
+	for _, operation := range operations {
+		if expected := documentOperation(operation.Contents).Id; operation.Id != expected {
+			t.Errorf("expected operation id %s, got %s", expected, operation.Id)
+		}
+	}
+}
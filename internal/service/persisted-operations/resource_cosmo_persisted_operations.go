@@ -0,0 +1,344 @@
+package persisted_operations
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	platformv1 "github.com/wundergraph/cosmo/connect-go/gen/proto/wg/cosmo/platform/v1"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
+)
+
+var (
+	_ resource.Resource                   = (*PersistedOperationsResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*PersistedOperationsResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*PersistedOperationsResource)(nil)
+)
+
+type PersistedOperationsResource struct {
+	client *api.PlatformClient
+}
+
+type PersistedOperationsResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	GraphName  types.String `tfsdk:"graph_name"`
+	Namespace  types.String `tfsdk:"namespace"`
+	ClientName types.String `tfsdk:"client_name"`
+	Documents  types.List   `tfsdk:"documents"`
+	Manifest   types.String `tfsdk:"manifest"`
+	Operations types.Map    `tfsdk:"operations"`
+}
+
+func NewPersistedOperationsResource() resource.Resource {
+	return &PersistedOperationsResource{}
+}
+
+func (r *PersistedOperationsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_persisted_operations"
+}
+
+func (r *PersistedOperationsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Publishes persisted operations of a client to a federated graph, like ` + "`wgc operations push`" + `. The operations are taken from GraphQL documents and/or a manifest in the Apollo, Relay or Cosmo format.
+
+Operations that are missing on the platform are published again on the next apply. The platform has no way of deleting persisted operations, destroying the resource only removes it from the Terraform state.
+
+For more information on persisted operations, please refer to the [Cosmo Documentation](https://cosmo-docs.wundergraph.com/router/persisted-queries/persisted-operations).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the persisted operations, composed of the graph name, namespace and client name.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the federated graph to publish the operations to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "The namespace of the federated graph.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("default"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"client_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the client the operations belong to. Clients send it in the `graphql-client-name` header.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"documents": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "GraphQL documents to persist. The id of an operation is the SHA-256 hash of its document.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"manifest": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A JSON manifest of the operations to persist, e.g. read with `file()`. Supported are Apollo persisted query manifests, Relay query maps and lists of Cosmo operations with `id` and `contents`.",
+			},
+			"operations": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: fmt.Sprintf("The status of each published operation by its id: `%s`, `%s` or `%s` if an operation with the same id but different contents already existed. Operations missing on the platform are left out, the next plan publishes them again.", api.PersistedOperationStatusCreated, api.PersistedOperationStatusUpToDate, api.PersistedOperationStatusConflict),
+			},
+		},
+	}
+}
+
+func (r *PersistedOperationsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.PlatformClient)
+	if !ok {
+		utils.AddDiagnosticError(resp, ErrUnexpectedDataSourceType, fmt.Sprintf("Expected *api.PlatformClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PersistedOperationsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data PersistedOperationsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Documents.IsNull() && data.Manifest.IsNull() {
+		resp.Diagnostics.AddError(ErrMissingOperations, "At least one of 'documents' or 'manifest' must be configured.")
+		return
+	}
+
+	if data.Manifest.IsNull() || data.Manifest.IsUnknown() {
+		return
+	}
+	if _, err := parseManifest(data.Manifest.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("manifest"), ErrInvalidManifest, err.Error())
+	}
+}
+
+// ModifyPlan plans to publish again if a configured operation is missing from
+// the published operations, e.g. because Read did not find it on the
+// platform.
+func (r *PersistedOperationsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state PersistedOperationsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() || plan.Operations.IsUnknown() || plan.Documents.IsUnknown() || plan.Manifest.IsUnknown() {
+		return
+	}
+
+	// Invalid manifests are reported by ValidateConfig.
+	var diags diag.Diagnostics
+	operations := collectOperations(ctx, &plan, &diags)
+	if diags.HasError() {
+		return
+	}
+
+	published := state.Operations.Elements()
+	for _, operation := range operations {
+		if _, ok := published[operation.Id]; !ok {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("operations"), types.MapUnknown(types.StringType))...)
+			return
+		}
+	}
+}
+
+func (r *PersistedOperationsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PersistedOperationsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.publish(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.LogAction(ctx, "created", data.Id.ValueString(), data.ClientName.ValueString(), data.Namespace.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PersistedOperationsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PersistedOperationsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	published, apiError := r.client.GetPersistedOperations(ctx, data.GraphName.ValueString(), data.Namespace.ValueString(), data.ClientName.ValueString())
+	if apiError != nil {
+		if api.IsNotFoundError(apiError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddDiagnosticError(resp, ErrReadingOperations, apiError.Error())
+		return
+	}
+
+	// Operations are compared by id only, an operation whose id exists with
+	// different contents is a conflict which publishing again cannot resolve.
+	// Operations which are missing on the platform are removed from the
+	// published ones, ModifyPlan plans to publish them again. The configured
+	// documents and manifest are left as they are.
+	ids := make(map[string]bool, len(published))
+	for _, operation := range published {
+		ids[operation.Id] = true
+	}
+
+	statuses := make(map[string]attr.Value, len(data.Operations.Elements()))
+	for id, status := range data.Operations.Elements() {
+		if ids[id] {
+			statuses[id] = status
+		}
+	}
+	data.Operations = types.MapValueMust(types.StringType, statuses)
+
+	utils.LogAction(ctx, "read", data.Id.ValueString(), data.ClientName.ValueString(), data.Namespace.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PersistedOperationsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PersistedOperationsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.publish(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.LogAction(ctx, "updated", data.Id.ValueString(), data.ClientName.ValueString(), data.Namespace.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PersistedOperationsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PersistedOperationsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Persisted operations cannot be deleted on the platform, they stay
+	// available to the router after the resource is destroyed.
+	utils.LogAction(ctx, "deleted", data.Id.ValueString(), data.ClientName.ValueString(), data.Namespace.ValueString())
+}
+
+// publish publishes the operations of the documents and the manifest and
+// stores the status the platform reports for each of them.
+func (r *PersistedOperationsResource) publish(ctx context.Context, data *PersistedOperationsResourceModel, diags *diag.Diagnostics) {
+	operations := collectOperations(ctx, data, diags)
+	if diags.HasError() {
+		return
+	}
+
+	published, apiError := r.client.PublishPersistedOperations(ctx, data.GraphName.ValueString(), data.Namespace.ValueString(), data.ClientName.ValueString(), operations)
+	if apiError != nil {
+		diags.AddError(ErrPublishingOperations, apiError.Error())
+		return
+	}
+
+	statuses := make(map[string]attr.Value, len(published))
+	var conflicts []string
+	for _, operation := range published {
+		status := api.PersistedOperationStatusString(operation.Status)
+		if status == api.PersistedOperationStatusConflict {
+			conflicts = append(conflicts, operation.Id)
+		}
+		statuses[operation.Id] = types.StringValue(status)
+	}
+
+	if len(conflicts) > 0 {
+		diags.AddWarning(ErrConflictingOperations,
+			fmt.Sprintf("Operations with the ids %s already exist with different contents and were not updated.", strings.Join(conflicts, ", ")))
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s-%s-%s", data.GraphName.ValueString(), data.Namespace.ValueString(), data.ClientName.ValueString()))
+	data.Operations = types.MapValueMust(types.StringType, statuses)
+}
+
+// collectOperations returns the operations of the documents and the manifest,
+// publishing each id only once.
+func collectOperations(ctx context.Context, data *PersistedOperationsResourceModel, diags *diag.Diagnostics) []*platformv1.PersistedOperation {
+	var operations []*platformv1.PersistedOperation
+
+	if !data.Documents.IsNull() {
+		var documents []string
+		diags.Append(data.Documents.ElementsAs(ctx, &documents, false)...)
+		if diags.HasError() {
+			return nil
+		}
+		for _, document := range documents {
+			operations = append(operations, documentOperation(document))
+		}
+	}
+
+	if !data.Manifest.IsNull() {
+		manifest, err := parseManifest(data.Manifest.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("manifest"), ErrInvalidManifest, err.Error())
+			return nil
+		}
+		operations = append(operations, manifest...)
+	}
+
+	seen := make(map[string]bool, len(operations))
+	unique := make([]*platformv1.PersistedOperation, 0, len(operations))
+	for _, operation := range operations {
+		if seen[operation.Id] {
+			continue
+		}
+		seen[operation.Id] = true
+		unique = append(unique, operation)
+	}
+
+	return unique
+}
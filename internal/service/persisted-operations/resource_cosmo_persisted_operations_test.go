@@ -0,0 +1,95 @@
+package persisted_operations_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+func TestAccPersistedOperationsResource(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	clientName := acctest.RandomWithPrefix("test-client")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPersistedOperationsResourceConfig(namespace, clientName, `documents = ["query { hello }"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_persisted_operations.test", "id", fmt.Sprintf("federated-graph-%s-%s", namespace, clientName)),
+					resource.TestCheckResourceAttr("cosmo_persisted_operations.test", "operations.%", "1"),
+					resource.TestCheckResourceAttr("cosmo_persisted_operations.test", "operations.ec2e01311ab3b02f3d8c8c712f9e579356d332cd007ac4c1ea5df727f482f05f", "created"),
+				),
+			},
+			{
+				ResourceName: "cosmo_persisted_operations.test",
+				RefreshState: true,
+			},
+			{
+				Config: testAccPersistedOperationsResourceConfig(namespace, clientName, `
+  documents = ["query { hello }"]
+  manifest  = jsonencode({
+    format  = "apollo-persisted-query-manifest"
+    version = 1
+    operations = [{ id = "hello-apollo", name = "Hello", type = "query", body = "query Hello { hello }" }]
+  })`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_persisted_operations.test", "operations.%", "2"),
+					resource.TestCheckResourceAttr("cosmo_persisted_operations.test", "operations.ec2e01311ab3b02f3d8c8c712f9e579356d332cd007ac4c1ea5df727f482f05f", "up_to_date"),
+					resource.TestCheckResourceAttr("cosmo_persisted_operations.test", "operations.hello-apollo", "created"),
+				),
+			},
+			{
+				Config:  testAccPersistedOperationsResourceConfig(namespace, clientName, `documents = ["query { hello }"]`),
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func TestAccPersistedOperationsResourceInvalidManifest(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	clientName := acctest.RandomWithPrefix("test-client")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPersistedOperationsResourceConfig(namespace, clientName, `manifest = "query { hello }"`),
+				ExpectError: regexp.MustCompile(`manifest is not valid JSON`),
+			},
+			{
+				Config:      testAccPersistedOperationsResourceConfig(namespace, clientName, ""),
+				ExpectError: regexp.MustCompile(`At least one of 'documents' or 'manifest' must be configured`),
+			},
+		},
+	})
+}
+
+func testAccPersistedOperationsResourceConfig(namespace, clientName, operations string) string {
+	return fmt.Sprintf(`
+resource "cosmo_namespace" "test" {
+  name = "%s"
+}
+
+resource "cosmo_federated_graph" "test" {
+  name        = "federated-graph"
+  namespace   = cosmo_namespace.test.name
+  routing_url = "https://example.com"
+}
+
+resource "cosmo_persisted_operations" "test" {
+  graph_name  = cosmo_federated_graph.test.name
+  namespace   = cosmo_namespace.test.name
+  client_name = "%s"
+  %s
+}
+`, namespace, clientName, operations)
+}
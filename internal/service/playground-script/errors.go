@@ -0,0 +1,11 @@
+package playground_script
+
+const (
+	ErrCreatingPlaygroundScript = "Error Creating Playground Script"
+	ErrReadingPlaygroundScript  = "Error Reading Playground Script"
+	ErrUpdatingPlaygroundScript = "Error Updating Playground Script"
+	ErrDeletingPlaygroundScript = "Error Deleting Playground Script"
+	ErrInvalidScriptContent     = "Invalid Playground Script Content"
+	ErrReadingContentFile       = "Error Reading Playground Script File"
+	ErrUnexpectedDataSourceType = "Unexpected Data Source Configure Type"
+)
@@ -0,0 +1,29 @@
+package playground_script_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+func TestAccCosmoPlaygroundScriptImportBasic(t *testing.T) {
+	title := acctest.RandomWithPrefix("test-script")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlaygroundScriptResourceConfig(title, "post-operation", "console.log(playground.response.body);"),
+			},
+			{
+				// import via id
+				ResourceName:      "cosmo_playground_script.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
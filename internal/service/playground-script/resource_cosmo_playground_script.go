@@ -0,0 +1,232 @@
+package playground_script
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
+)
+
+var (
+	_ resource.Resource                   = (*PlaygroundScriptResource)(nil)
+	_ resource.ResourceWithImportState    = (*PlaygroundScriptResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*PlaygroundScriptResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*PlaygroundScriptResource)(nil)
+)
+
+type PlaygroundScriptResource struct {
+	client *api.PlatformClient
+}
+
+type PlaygroundScriptResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Title       types.String `tfsdk:"title"`
+	Type        types.String `tfsdk:"type"`
+	Content     types.String `tfsdk:"content"`
+	ContentFile types.String `tfsdk:"content_file"`
+}
+
+func NewPlaygroundScriptResource() resource.Resource {
+	return &PlaygroundScriptResource{}
+}
+
+func (r *PlaygroundScriptResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_playground_script"
+}
+
+func (r *PlaygroundScriptResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Creates a script which is shared with all members of the organization in the Studio playground, e.g. to fetch an auth token before each operation.
+
+For more information on playground scripts, please refer to the [Cosmo Documentation](https://cosmo-docs.wundergraph.com/studio/playground/shared-playground-scripts).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the playground script.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The title of the playground script.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: fmt.Sprintf("When the script runs: `%s`, `%s` or `%s`.", api.PlaygroundScriptTypePreFlight, api.PlaygroundScriptTypePreOperation, api.PlaygroundScriptTypePostOperation),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(api.PlaygroundScriptTypes...),
+				},
+			},
+			"content": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The JavaScript code of the script. Conflicts with `content_file`, which sets it to the contents of the file.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("content_file")),
+				},
+			},
+			"content_file": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The path of a file with the JavaScript code of the script. Changes of the file are detected on plan.",
+			},
+		},
+	}
+}
+
+func (r *PlaygroundScriptResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.PlatformClient)
+	if !ok {
+		utils.AddDiagnosticError(resp, ErrUnexpectedDataSourceType, fmt.Sprintf("Expected *api.PlatformClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PlaygroundScriptResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data PlaygroundScriptResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Content.IsNull() && data.ContentFile.IsNull() {
+		resp.Diagnostics.AddError(ErrInvalidScriptContent, "One of 'content' or 'content_file' must be configured.")
+	}
+}
+
+// ModifyPlan plans the contents of the configured file as the content of the
+// script, so that changes of the file show up in the plan.
+func (r *PlaygroundScriptResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data PlaygroundScriptResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.ContentFile.IsNull() || data.ContentFile.IsUnknown() {
+		return
+	}
+
+	content, err := os.ReadFile(data.ContentFile.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("content_file"), ErrReadingContentFile, err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content"), string(content))...)
+}
+
+func (r *PlaygroundScriptResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PlaygroundScriptResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	script, apiError := r.client.CreatePlaygroundScript(ctx, data.Title.ValueString(), data.Type.ValueString(), data.Content.ValueString())
+	if apiError != nil {
+		utils.AddDiagnosticError(resp, ErrCreatingPlaygroundScript, apiError.Error())
+		return
+	}
+
+	data.Id = types.StringValue(script.Id)
+
+	utils.LogAction(ctx, "created", data.Id.ValueString(), data.Title.ValueString(), "")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PlaygroundScriptResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PlaygroundScriptResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	script, apiError := r.client.GetPlaygroundScript(ctx, data.Id.ValueString(), data.Type.ValueString())
+	if apiError != nil {
+		if api.IsNotFoundError(apiError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddDiagnosticError(resp, ErrReadingPlaygroundScript, apiError.Error())
+		return
+	}
+
+	data.Title = types.StringValue(script.Title)
+	data.Type = types.StringValue(script.Type)
+	data.Content = types.StringValue(script.Content)
+
+	utils.LogAction(ctx, "read", data.Id.ValueString(), data.Title.ValueString(), "")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PlaygroundScriptResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PlaygroundScriptResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if apiError := r.client.UpdatePlaygroundScript(ctx, data.Id.ValueString(), data.Title.ValueString(), data.Content.ValueString()); apiError != nil {
+		utils.AddDiagnosticError(resp, ErrUpdatingPlaygroundScript, apiError.Error())
+		return
+	}
+
+	utils.LogAction(ctx, "updated", data.Id.ValueString(), data.Title.ValueString(), "")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PlaygroundScriptResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PlaygroundScriptResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if apiError := r.client.DeletePlaygroundScript(ctx, data.Id.ValueString()); apiError != nil {
+		if api.IsNotFoundError(apiError) {
+			return
+		}
+		utils.AddDiagnosticError(resp, ErrDeletingPlaygroundScript, apiError.Error())
+		return
+	}
+
+	utils.LogAction(ctx, "deleted", data.Id.ValueString(), data.Title.ValueString(), "")
+}
+
+func (r *PlaygroundScriptResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
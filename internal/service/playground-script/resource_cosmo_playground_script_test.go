@@ -0,0 +1,122 @@
+package playground_script_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+func TestAccPlaygroundScriptResource(t *testing.T) {
+	title := acctest.RandomWithPrefix("test-script")
+	content := `playground.request.headers.set("Authorization", "Bearer token");`
+	updatedContent := `playground.request.headers.set("Authorization", "Bearer updated-token");`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlaygroundScriptResourceConfig(title, "pre-operation", content),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_playground_script.test", "title", title),
+					resource.TestCheckResourceAttr("cosmo_playground_script.test", "type", "pre-operation"),
+					resource.TestCheckResourceAttr("cosmo_playground_script.test", "content", content),
+					resource.TestCheckResourceAttrSet("cosmo_playground_script.test", "id"),
+				),
+			},
+			{
+				ResourceName: "cosmo_playground_script.test",
+				RefreshState: true,
+			},
+			{
+				Config: testAccPlaygroundScriptResourceConfig(title+"-updated", "pre-operation", updatedContent),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_playground_script.test", "title", title+"-updated"),
+					resource.TestCheckResourceAttr("cosmo_playground_script.test", "content", updatedContent),
+				),
+			},
+			{
+				Config:  testAccPlaygroundScriptResourceConfig(title+"-updated", "pre-operation", updatedContent),
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func TestAccPlaygroundScriptResourceContentFile(t *testing.T) {
+	title := acctest.RandomWithPrefix("test-script")
+	file := filepath.Join(t.TempDir(), "script.js")
+	content := `console.log("pre-flight");`
+	updatedContent := `console.log("updated pre-flight");`
+
+	writeFile := func(content string) func() {
+		return func() {
+			if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: writeFile(content),
+				Config:    testAccPlaygroundScriptResourceFileConfig(title, file),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_playground_script.test", "content", content),
+				),
+			},
+			{
+				PreConfig: writeFile(updatedContent),
+				Config:    testAccPlaygroundScriptResourceFileConfig(title, file),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_playground_script.test", "content", updatedContent),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPlaygroundScriptResourceInvalidType(t *testing.T) {
+	title := acctest.RandomWithPrefix("test-script")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPlaygroundScriptResourceConfig(title, "post-flight", "console.log(1);"),
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+		},
+	})
+}
+
+func testAccPlaygroundScriptResourceConfig(title, scriptType, content string) string {
+	return fmt.Sprintf(`
+resource "cosmo_playground_script" "test" {
+  title   = "%s"
+  type    = "%s"
+  content = %q
+}
+`, title, scriptType, content)
+}
+
+func testAccPlaygroundScriptResourceFileConfig(title, file string) string {
+	return fmt.Sprintf(`
+resource "cosmo_playground_script" "test" {
+  title        = "%s"
+  type         = "pre-flight"
+  content_file = "%s"
+}
+`, title, file)
+}
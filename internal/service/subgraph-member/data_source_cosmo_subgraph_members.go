@@ -0,0 +1,130 @@
+package subgraph_member
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SubgraphMembersDataSource{}
+
+func NewSubgraphMembersDataSource() datasource.DataSource {
+	return &SubgraphMembersDataSource{}
+}
+
+// SubgraphMembersDataSource defines the data source implementation.
+type SubgraphMembersDataSource struct {
+	client *api.PlatformClient
+}
+
+// SubgraphMembersDataSourceModel describes the data source data model.
+type SubgraphMembersDataSourceModel struct {
+	SubgraphName types.String          `tfsdk:"subgraph_name"`
+	Namespace    types.String          `tfsdk:"namespace"`
+	Members      []SubgraphMemberModel `tfsdk:"members"`
+}
+
+type SubgraphMemberModel struct {
+	Id     types.String `tfsdk:"id"`
+	UserId types.String `tfsdk:"user_id"`
+	Email  types.String `tfsdk:"email"`
+}
+
+func (d *SubgraphMembersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subgraph_members"
+}
+
+func (d *SubgraphMembersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Cosmo Subgraph Members Data Source",
+
+		Attributes: map[string]schema.Attribute{
+			"subgraph_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the subgraph.",
+			},
+			"namespace": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The namespace of the subgraph. Defaults to the default namespace.",
+			},
+			"members": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The users allowed to publish the subgraph.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the subgraph member.",
+						},
+						"user_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the user.",
+						},
+						"email": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The email of the user.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SubgraphMembersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.PlatformClient)
+	if !ok {
+		utils.AddDiagnosticError(resp, ErrUnexpectedDataSourceType, fmt.Sprintf("Expected *api.PlatformClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SubgraphMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SubgraphMembersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	namespace := data.Namespace.ValueString()
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	members, apiError := d.client.GetSubgraphMembers(ctx, data.SubgraphName.ValueString(), namespace)
+	if apiError != nil {
+		utils.AddDiagnosticError(resp, ErrReadingSubgraphMembers, apiError.Error())
+		return
+	}
+
+	data.Namespace = types.StringValue(namespace)
+	data.Members = make([]SubgraphMemberModel, 0, len(members))
+	for _, member := range members {
+		data.Members = append(data.Members, SubgraphMemberModel{
+			Id:     types.StringValue(member.SubgraphMemberId),
+			UserId: types.StringValue(member.UserId),
+			Email:  types.StringValue(member.Email),
+		})
+	}
+
+	tflog.Trace(ctx, "Read subgraph members data source", map[string]interface{}{
+		"subgraph_name": data.SubgraphName.ValueString(),
+		"namespace":     namespace,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,41 @@
+package subgraph_member_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+func TestAccSubgraphMembersDataSource(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	subgraphName := acctest.RandomWithPrefix("test-subgraph")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubgraphMembersDataSourceConfig(namespace, subgraphName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cosmo_subgraph_members.test", "members.#", "1"),
+					resource.TestCheckResourceAttr("data.cosmo_subgraph_members.test", "members.0.email", memberEmail),
+					resource.TestCheckResourceAttrPair("data.cosmo_subgraph_members.test", "members.0.id", "cosmo_subgraph_member.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSubgraphMembersDataSourceConfig(namespace, subgraphName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "cosmo_subgraph_members" "test" {
+  subgraph_name = cosmo_subgraph_member.test.subgraph_name
+  namespace     = cosmo_subgraph_member.test.namespace
+}
+`, testAccSubgraphMemberResourceConfig(namespace, subgraphName, memberEmail))
+}
@@ -0,0 +1,11 @@
+package subgraph_member
+
+const (
+	ErrAddingSubgraphMember     = "Error Adding Subgraph Member"
+	ErrReadingSubgraphMember    = "Error Reading Subgraph Member"
+	ErrReadingSubgraphMembers   = "Error Reading Subgraph Members"
+	ErrUpdatingSubgraphMember   = "Error Updating Subgraph Member"
+	ErrRemovingSubgraphMember   = "Error Removing Subgraph Member"
+	ErrInvalidImportId          = "Invalid Import ID"
+	ErrUnexpectedDataSourceType = "Unexpected Data Source Configure Type"
+)
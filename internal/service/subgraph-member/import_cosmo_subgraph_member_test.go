@@ -0,0 +1,32 @@
+package subgraph_member_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+func TestAccCosmoSubgraphMemberImportBasic(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	subgraphName := acctest.RandomWithPrefix("test-subgraph")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubgraphMemberResourceConfig(namespace, subgraphName, memberEmail),
+			},
+			{
+				// import via namespace, subgraph name and email
+				ResourceName:      "cosmo_subgraph_member.test",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s/%s", namespace, subgraphName, memberEmail),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,202 @@
+package subgraph_member
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
+)
+
+var (
+	_ resource.Resource                = (*SubgraphMemberResource)(nil)
+	_ resource.ResourceWithImportState = (*SubgraphMemberResource)(nil)
+)
+
+type SubgraphMemberResource struct {
+	client *api.PlatformClient
+}
+
+type SubgraphMemberResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	SubgraphName types.String `tfsdk:"subgraph_name"`
+	Namespace    types.String `tfsdk:"namespace"`
+	Email        types.String `tfsdk:"email"`
+	UserId       types.String `tfsdk:"user_id"`
+}
+
+func NewSubgraphMemberResource() resource.Resource {
+	return &SubgraphMemberResource{}
+}
+
+func (r *SubgraphMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subgraph_member"
+}
+
+func (r *SubgraphMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Grants a member of the organization the permission to publish a subgraph. The user must already be a member of the organization.
+
+For more information on subgraph members, please refer to the [Cosmo Documentation](https://cosmo-docs.wundergraph.com/studio/rbac).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the subgraph member.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subgraph_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the subgraph.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("default"),
+				MarkdownDescription: "The namespace of the subgraph.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The email of the user to add to the subgraph.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SubgraphMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.PlatformClient)
+	if !ok {
+		utils.AddDiagnosticError(resp, ErrUnexpectedDataSourceType, fmt.Sprintf("Expected *api.PlatformClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SubgraphMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SubgraphMemberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subgraphName, namespace := data.SubgraphName.ValueString(), data.Namespace.ValueString()
+	if apiError := r.client.AddSubgraphMember(ctx, subgraphName, namespace, data.Email.ValueString()); apiError != nil {
+		utils.AddDiagnosticError(resp, ErrAddingSubgraphMember, apiError.Error())
+		return
+	}
+
+	member, apiError := r.client.GetSubgraphMember(ctx, subgraphName, namespace, data.Email.ValueString())
+	if apiError != nil {
+		utils.AddDiagnosticError(resp, ErrReadingSubgraphMember, apiError.Error())
+		return
+	}
+
+	data.Id = types.StringValue(member.SubgraphMemberId)
+	data.UserId = types.StringValue(member.UserId)
+
+	utils.LogAction(ctx, "created", data.Id.ValueString(), subgraphName, namespace)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubgraphMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SubgraphMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subgraphName, namespace := data.SubgraphName.ValueString(), data.Namespace.ValueString()
+	member, apiError := r.client.GetSubgraphMember(ctx, subgraphName, namespace, data.Email.ValueString())
+	if apiError != nil {
+		if api.IsNotFoundError(apiError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddDiagnosticError(resp, ErrReadingSubgraphMember, apiError.Error())
+		return
+	}
+
+	data.Id = types.StringValue(member.SubgraphMemberId)
+	data.UserId = types.StringValue(member.UserId)
+
+	utils.LogAction(ctx, "read", data.Id.ValueString(), subgraphName, namespace)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubgraphMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	utils.AddDiagnosticError(resp, ErrUpdatingSubgraphMember, "Subgraph member update should never be called, please delete and recreate the subgraph member")
+}
+
+func (r *SubgraphMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SubgraphMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subgraphName, namespace := data.SubgraphName.ValueString(), data.Namespace.ValueString()
+	if apiError := r.client.RemoveSubgraphMember(ctx, subgraphName, namespace, data.Id.ValueString()); apiError != nil {
+		if api.IsNotFoundError(apiError) {
+			return
+		}
+		utils.AddDiagnosticError(resp, ErrRemovingSubgraphMember, apiError.Error())
+		return
+	}
+
+	utils.LogAction(ctx, "deleted", data.Id.ValueString(), subgraphName, namespace)
+}
+
+// ImportState imports a subgraph member by "<namespace>/<subgraph name>/<email>".
+func (r *SubgraphMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		utils.AddDiagnosticError(resp, ErrInvalidImportId, fmt.Sprintf("Expected an import ID of the form <namespace>/<subgraph name>/<email>, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subgraph_name"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), parts[2])...)
+}
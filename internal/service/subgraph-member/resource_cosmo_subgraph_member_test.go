@@ -0,0 +1,82 @@
+package subgraph_member_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+// memberEmail is the user seeded into the organization of the local
+// development setup of the platform.
+const memberEmail = "foo@wundergraph.com"
+
+func TestAccSubgraphMemberResource(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	subgraphName := acctest.RandomWithPrefix("test-subgraph")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubgraphMemberResourceConfig(namespace, subgraphName, memberEmail),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_subgraph_member.test", "subgraph_name", subgraphName),
+					resource.TestCheckResourceAttr("cosmo_subgraph_member.test", "namespace", namespace),
+					resource.TestCheckResourceAttr("cosmo_subgraph_member.test", "email", memberEmail),
+					resource.TestCheckResourceAttrSet("cosmo_subgraph_member.test", "id"),
+					resource.TestCheckResourceAttrSet("cosmo_subgraph_member.test", "user_id"),
+				),
+			},
+			{
+				ResourceName: "cosmo_subgraph_member.test",
+				RefreshState: true,
+			},
+			{
+				Config:  testAccSubgraphMemberResourceConfig(namespace, subgraphName, memberEmail),
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func TestAccSubgraphMemberResourceUnknownUser(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	subgraphName := acctest.RandomWithPrefix("test-subgraph")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSubgraphMemberResourceConfig(namespace, subgraphName, "unknown@example.com"),
+				ExpectError: regexp.MustCompile(`Error Adding Subgraph Member`),
+			},
+		},
+	})
+}
+
+func testAccSubgraphMemberResourceConfig(namespace, subgraphName, email string) string {
+	return fmt.Sprintf(`
+resource "cosmo_namespace" "test" {
+  name = "%s"
+}
+
+resource "cosmo_subgraph" "test" {
+  name        = "%s"
+  namespace   = cosmo_namespace.test.name
+  routing_url = "https://subgraph-member-example.com"
+}
+
+resource "cosmo_subgraph_member" "test" {
+  subgraph_name = cosmo_subgraph.test.name
+  namespace     = cosmo_namespace.test.name
+  email         = "%s"
+}
+`, namespace, subgraphName, email)
+}
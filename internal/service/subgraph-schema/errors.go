@@ -0,0 +1,11 @@
+package subgraph_schema
+
+const (
+	ErrPublishingSubgraphSchema  = "Error Publishing Subgraph Schema"
+	ErrReadingSubgraphSchema     = "Error Reading Subgraph Schema"
+	ErrSubgraphCompositionFailed = "Subgraph Composition Failed"
+	ErrInvalidSubgraphSchema     = "Invalid Subgraph Schema"
+	ErrMissingBaselineSchema     = "Missing Baseline Schema"
+	ErrInvalidImportId           = "Invalid Import ID"
+	ErrUnexpectedDataSourceType  = "Unexpected Data Source Configure Type"
+)
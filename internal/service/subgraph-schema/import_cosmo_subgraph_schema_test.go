@@ -0,0 +1,32 @@
+package subgraph_schema_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+func TestAccCosmoSubgraphSchemaImportBasic(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	subgraphName := acctest.RandomWithPrefix("test-subgraph")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubgraphSchemaResourceConfig(namespace, subgraphName, acceptance.TestAccValidSubgraphSchema, "keep", ""),
+			},
+			{
+				// import via namespace and subgraph name
+				ResourceName:      "cosmo_subgraph_schema.test",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s", namespace, subgraphName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,321 @@
+package subgraph_schema
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/api"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/utils"
+)
+
+const (
+	// CompositionFailureError fails the apply if the published schema does
+	// not compose, the schema is published again on the next apply.
+	CompositionFailureError = "error"
+	// CompositionFailureWarn accepts a published schema which does not
+	// compose and only reports a warning.
+	CompositionFailureWarn = "warn"
+
+	// OnDestroyKeep leaves the latest published schema in place.
+	OnDestroyKeep = "keep"
+	// OnDestroyPublishBaseline publishes the baseline schema on destroy.
+	OnDestroyPublishBaseline = "publish_baseline"
+)
+
+var (
+	_ resource.Resource                   = (*SubgraphSchemaResource)(nil)
+	_ resource.ResourceWithImportState    = (*SubgraphSchemaResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*SubgraphSchemaResource)(nil)
+)
+
+type SubgraphSchemaResource struct {
+	client *api.PlatformClient
+}
+
+type SubgraphSchemaResourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	SubgraphName       types.String `tfsdk:"subgraph_name"`
+	Namespace          types.String `tfsdk:"namespace"`
+	Schema             types.String `tfsdk:"schema"`
+	CompositionFailure types.String `tfsdk:"composition_failure"`
+	OnDestroy          types.String `tfsdk:"on_destroy"`
+	BaselineSchema     types.String `tfsdk:"baseline_schema"`
+}
+
+func NewSubgraphSchemaResource() resource.Resource {
+	return &SubgraphSchemaResource{}
+}
+
+func (r *SubgraphSchemaResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subgraph_schema"
+}
+
+func (r *SubgraphSchemaResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Publishes the schema of a subgraph and tracks the latest published SDL. The subgraph itself, e.g. its routing URL and labels, is registered with ` + "`cosmo_subgraph`" + `, which must not configure a ` + "`schema`" + ` when the schema is managed with this resource.
+
+For more information on publishing subgraphs, please refer to the [Cosmo Documentation](https://cosmo-docs.wundergraph.com/cli/subgraphs/publish).
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the subgraph.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subgraph_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the subgraph.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("default"),
+				MarkdownDescription: "The namespace of the subgraph.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schema": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The schema to publish.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"composition_failure": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(CompositionFailureError),
+				MarkdownDescription: fmt.Sprintf(
+					"How to handle a published schema which does not compose: `%s` fails the apply and publishes the schema again on the next apply, `%s` only reports a warning. Defaults to `%s`.",
+					CompositionFailureError, CompositionFailureWarn, CompositionFailureError,
+				),
+				Validators: []validator.String{
+					stringvalidator.OneOf(CompositionFailureError, CompositionFailureWarn),
+				},
+			},
+			"on_destroy": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(OnDestroyKeep),
+				MarkdownDescription: fmt.Sprintf(
+					"What happens to the published schema when the resource is destroyed: `%s` leaves it in place, `%s` publishes `baseline_schema`. Defaults to `%s`.",
+					OnDestroyKeep, OnDestroyPublishBaseline, OnDestroyKeep,
+				),
+				Validators: []validator.String{
+					stringvalidator.OneOf(OnDestroyKeep, OnDestroyPublishBaseline),
+				},
+			},
+			"baseline_schema": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("The schema published on destroy if `on_destroy` is `%s`, which requires it.", OnDestroyPublishBaseline),
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *SubgraphSchemaResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SubgraphSchemaResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.OnDestroy.ValueString() != OnDestroyPublishBaseline {
+		return
+	}
+
+	if data.BaselineSchema.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("baseline_schema"),
+			ErrMissingBaselineSchema,
+			fmt.Sprintf("baseline_schema is required if on_destroy is %q, publishing an empty schema would break the composition.", OnDestroyPublishBaseline),
+		)
+	}
+}
+
+func (r *SubgraphSchemaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.PlatformClient)
+	if !ok {
+		utils.AddDiagnosticError(resp, ErrUnexpectedDataSourceType, fmt.Sprintf("Expected *api.PlatformClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SubgraphSchemaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SubgraphSchemaResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.publish(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.LogAction(ctx, "created", data.Id.ValueString(), data.SubgraphName.ValueString(), data.Namespace.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubgraphSchemaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SubgraphSchemaResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subgraphName, namespace := data.SubgraphName.ValueString(), data.Namespace.ValueString()
+	subgraph, apiError := r.client.GetSubgraph(ctx, subgraphName, namespace)
+	if apiError != nil {
+		if api.IsNotFoundError(apiError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddDiagnosticError(resp, ErrReadingSubgraphSchema, apiError.Error())
+		return
+	}
+
+	subgraphSchema, apiError := r.client.GetSubgraphSchema(ctx, subgraphName, namespace)
+	if apiError != nil {
+		if api.IsNotFoundError(apiError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddDiagnosticError(resp, ErrReadingSubgraphSchema, apiError.Error())
+		return
+	}
+
+	data.Id = types.StringValue(subgraph.GetId())
+	data.Schema = types.StringValue(subgraphSchema)
+	// Imported resources have no policies in the state yet.
+	if data.CompositionFailure.IsNull() {
+		data.CompositionFailure = types.StringValue(CompositionFailureError)
+	}
+	if data.OnDestroy.IsNull() {
+		data.OnDestroy = types.StringValue(OnDestroyKeep)
+	}
+
+	utils.LogAction(ctx, "read", data.Id.ValueString(), subgraphName, namespace)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubgraphSchemaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SubgraphSchemaResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.publish(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.LogAction(ctx, "updated", data.Id.ValueString(), data.SubgraphName.ValueString(), data.Namespace.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubgraphSchemaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SubgraphSchemaResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subgraphName, namespace := data.SubgraphName.ValueString(), data.Namespace.ValueString()
+	// States written before baseline_schema was required may lack it, the
+	// schema is kept in place then.
+	if data.OnDestroy.ValueString() == OnDestroyPublishBaseline && data.BaselineSchema.ValueString() == "" {
+		utils.AddDiagnosticWarning(resp, ErrMissingBaselineSchema, "No baseline_schema is set, the latest published schema is kept.")
+	} else if data.OnDestroy.ValueString() == OnDestroyPublishBaseline {
+		_, apiError := r.client.PublishSubgraph(ctx, subgraphName, namespace, data.BaselineSchema.ValueString())
+		if apiError != nil {
+			switch {
+			case api.IsNotFoundError(apiError):
+				// The subgraph is gone together with its schema.
+			case api.IsSubgraphCompositionFailedError(apiError):
+				utils.AddDiagnosticWarning(resp, ErrSubgraphCompositionFailed, apiError.Error())
+			default:
+				utils.AddDiagnosticError(resp, ErrPublishingSubgraphSchema, apiError.Error())
+				return
+			}
+		}
+	}
+
+	utils.LogAction(ctx, "deleted", data.Id.ValueString(), subgraphName, namespace)
+}
+
+// ImportState imports a subgraph schema by "<namespace>/<subgraph name>".
+func (r *SubgraphSchemaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		utils.AddDiagnosticError(resp, ErrInvalidImportId, fmt.Sprintf("Expected an import ID of the form <namespace>/<subgraph name>, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subgraph_name"), parts[1])...)
+}
+
+// publish publishes the planned schema and handles a failed composition
+// according to the configured policy.
+func (r *SubgraphSchemaResource) publish(ctx context.Context, data *SubgraphSchemaResourceModel, diags *diag.Diagnostics) {
+	subgraphName, namespace := data.SubgraphName.ValueString(), data.Namespace.ValueString()
+
+	_, apiError := r.client.PublishSubgraph(ctx, subgraphName, namespace, data.Schema.ValueString())
+	if apiError != nil {
+		switch {
+		case api.IsSubgraphCompositionFailedError(apiError):
+			if data.CompositionFailure.ValueString() == CompositionFailureError {
+				diags.AddError(ErrSubgraphCompositionFailed, apiError.Error())
+				return
+			}
+			diags.AddWarning(ErrSubgraphCompositionFailed, apiError.Error())
+		case api.IsInvalidSubgraphSchemaError(apiError):
+			diags.AddError(ErrInvalidSubgraphSchema, apiError.Error())
+			return
+		default:
+			diags.AddError(ErrPublishingSubgraphSchema, apiError.Error())
+			return
+		}
+	}
+
+	subgraph, apiError := r.client.GetSubgraph(ctx, subgraphName, namespace)
+	if apiError != nil {
+		diags.AddError(ErrReadingSubgraphSchema, apiError.Error())
+		return
+	}
+
+	data.Id = types.StringValue(subgraph.GetId())
+}
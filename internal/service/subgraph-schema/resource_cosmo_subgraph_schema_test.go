@@ -0,0 +1,132 @@
+package subgraph_schema_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/acceptance"
+)
+
+const updatedSubgraphSchema = `
+type Query {
+  hello: String!
+}
+`
+
+func TestAccSubgraphSchemaResource(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	subgraphName := acctest.RandomWithPrefix("test-subgraph")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubgraphSchemaResourceConfig(namespace, subgraphName, acceptance.TestAccValidSubgraphSchema, "keep", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_subgraph_schema.test", "subgraph_name", subgraphName),
+					resource.TestCheckResourceAttr("cosmo_subgraph_schema.test", "namespace", namespace),
+					resource.TestCheckResourceAttr("cosmo_subgraph_schema.test", "composition_failure", "error"),
+					resource.TestCheckResourceAttrPair("cosmo_subgraph_schema.test", "id", "cosmo_subgraph.test", "id"),
+					resource.TestCheckResourceAttrSet("cosmo_subgraph_schema.test", "schema"),
+					resource.TestCheckNoResourceAttr("cosmo_subgraph.test", "schema"),
+				),
+			},
+			{
+				Config: testAccSubgraphSchemaResourceConfig(namespace, subgraphName, updatedSubgraphSchema, "publish_baseline", acceptance.TestAccValidSubgraphSchema),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cosmo_subgraph_schema.test", "on_destroy", "publish_baseline"),
+					resource.TestCheckResourceAttrSet("cosmo_subgraph_schema.test", "schema"),
+					resource.TestCheckNoResourceAttr("cosmo_subgraph.test", "schema"),
+				),
+			},
+			{
+				ResourceName: "cosmo_subgraph_schema.test",
+				RefreshState: true,
+			},
+			{
+				Config:  testAccSubgraphSchemaResourceConfig(namespace, subgraphName, updatedSubgraphSchema, "publish_baseline", acceptance.TestAccValidSubgraphSchema),
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func TestAccSubgraphSchemaResourceInvalidSchema(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	subgraphName := acctest.RandomWithPrefix("test-subgraph")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSubgraphSchemaResourceConfig(namespace, subgraphName, "invalid", "keep", ""),
+				ExpectError: regexp.MustCompile(`Invalid Subgraph Schema`),
+			},
+		},
+	})
+}
+
+func TestAccSubgraphSchemaResourceInvalidPolicy(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSubgraphSchemaResourceConfig("test-namespace", "test-subgraph", updatedSubgraphSchema, "delete", ""),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
+
+func TestAccSubgraphSchemaResourceMissingBaselineSchema(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSubgraphSchemaResourceConfig("test-namespace", "test-subgraph", updatedSubgraphSchema, "publish_baseline", ""),
+				ExpectError: regexp.MustCompile(`Missing Baseline Schema`),
+			},
+		},
+	})
+}
+
+// testAccSubgraphSchemaResourceConfig leaves baseline_schema unset if
+// baselineSchema is empty.
+func testAccSubgraphSchemaResourceConfig(namespace, subgraphName, subgraphSchema, onDestroy, baselineSchema string) string {
+	baseline := ""
+	if baselineSchema != "" {
+		baseline = fmt.Sprintf(`
+  baseline_schema = <<-EOT
+  %s
+  EOT`, baselineSchema)
+	}
+
+	return fmt.Sprintf(`
+resource "cosmo_namespace" "test" {
+  name = "%s"
+}
+
+resource "cosmo_subgraph" "test" {
+  name        = "%s"
+  namespace   = cosmo_namespace.test.name
+  routing_url = "https://subgraph-schema-example.com"
+}
+
+resource "cosmo_subgraph_schema" "test" {
+  subgraph_name = cosmo_subgraph.test.name
+  namespace     = cosmo_namespace.test.name
+  on_destroy    = "%s"
+  schema        = <<-EOT
+  %s
+  EOT%s
+}
+`, namespace, subgraphName, onDestroy, subgraphSchema, baseline)
+}
@@ -182,7 +182,7 @@ func (d *SubgraphDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 
 	data.Labels = types.MapValueMust(types.StringType, labels)
-	data.Readme = types.StringValue(subgraph.GetReadme())
+	data.Readme = types.StringPointerValue(subgraph.Readme)
 	data.IsEventDrivenGraph = types.BoolValue(subgraph.GetIsEventDrivenGraph())
 	data.SubscriptionProtocol = types.StringValue(subgraph.GetSubscriptionProtocol())
 	data.WebsocketSubprotocol = types.StringValue(subgraph.GetWebsocketSubprotocol())
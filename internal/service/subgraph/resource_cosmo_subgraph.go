@@ -131,7 +131,7 @@ For more information on subgraphs, please refer to the [Cosmo Documentation](htt
 			},
 			"is_event_driven_graph": schema.BoolAttribute{
 				Optional:            true,
-				MarkdownDescription: "Indicates if the subgraph is event-driven.",
+				MarkdownDescription: "Indicates if the subgraph is event-driven. Consider `cosmo_event_driven_subgraph`, which validates the EDFS directives of the schema on plan.",
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
@@ -147,7 +147,7 @@ For more information on subgraphs, please refer to the [Cosmo Documentation](htt
 			},
 			"schema": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "The schema for the subgraph.",
+				MarkdownDescription: "The schema for the subgraph. Leave unset if the schema is published with `cosmo_subgraph_schema`.",
 			},
 		},
 	}
@@ -210,7 +210,9 @@ func (r *SubgraphResource) Create(ctx context.Context, req resource.CreateReques
 		data.Readme = types.StringValue(subgraph.GetReadme())
 	}
 
-	if len(subgraphSchema) > 0 {
+	// The schema may be published with cosmo_subgraph_schema instead, it is
+	// only tracked if it is configured on the subgraph.
+	if !data.Schema.IsNull() && len(subgraphSchema) > 0 {
 		data.Schema = types.StringValue(subgraphSchema)
 	}
 
@@ -231,7 +233,8 @@ func (r *SubgraphResource) Read(ctx context.Context, req resource.ReadRequest, r
 	var subgraph *platformv1.Subgraph
 	// We're doing an import if the name isn't provided and therefore we need
 	// to fetch the subgraph by ID and namespace.
-	if data.Name.ValueString() == "" {
+	importing := data.Name.ValueString() == ""
+	if importing {
 		subgraph, apiError = r.client.GetSubgraphById(ctx, data.Id.ValueString())
 		if apiError != nil {
 			if api.IsNotFoundError(apiError) {
@@ -306,7 +309,9 @@ func (r *SubgraphResource) Read(ctx context.Context, req resource.ReadRequest, r
 		data.Readme = types.StringValue(subgraph.GetReadme())
 	}
 
-	if len(subgraphSchema) > 0 {
+	// The schema may be published with cosmo_subgraph_schema instead, it is
+	// only tracked if it is configured on the subgraph or on import.
+	if (importing || !data.Schema.IsNull()) && len(subgraphSchema) > 0 {
 		data.Schema = types.StringValue(subgraphSchema)
 	}
 
@@ -459,7 +464,9 @@ func (r *SubgraphResource) Update(ctx context.Context, req resource.UpdateReques
 		data.Readme = types.StringValue(subgraph.GetReadme())
 	}
 
-	if len(subgraphSchema) > 0 {
+	// The schema may be published with cosmo_subgraph_schema instead, it is
+	// only tracked if it is configured on the subgraph.
+	if !data.Schema.IsNull() && len(subgraphSchema) > 0 {
 		data.Schema = types.StringValue(subgraphSchema)
 	}
 
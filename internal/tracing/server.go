@@ -0,0 +1,142 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/tracing"
+
+var (
+	ResourceTypeKey      = attribute.Key("cosmo.resource.type")
+	ResourceOperationKey = attribute.Key("cosmo.resource.operation")
+	ResourceIdKey        = attribute.Key("cosmo.resource.id")
+	ResourceNameKey      = attribute.Key("cosmo.resource.name")
+	ResourceNamespaceKey = attribute.Key("cosmo.resource.namespace")
+)
+
+// ProviderServer wraps the server of the provider to start a span for every
+// create, read, update, delete and import of a resource and every read of a
+// data source, e.g. "cosmo_subgraph create". The calls of the PlatformClient
+// made by the resource become children of the span.
+func ProviderServer(server tfprotov6.ProviderServer, tracerProvider trace.TracerProvider) tfprotov6.ProviderServer {
+	return &tracedServer{
+		ProviderServer: server,
+		tracer:         tracerProvider.Tracer(tracerName),
+	}
+}
+
+type tracedServer struct {
+	tfprotov6.ProviderServer
+	tracer trace.Tracer
+}
+
+func (s *tracedServer) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	ctx, span := s.start(ctx, req.TypeName, "read")
+	defer span.End()
+
+	resp, err := s.ProviderServer.ReadResource(ctx, req)
+	if resp != nil {
+		setStatus(span, resp.Diagnostics)
+	}
+	recordError(span, err)
+	return resp, err
+}
+
+func (s *tracedServer) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	ctx, span := s.start(ctx, req.TypeName, applyOperation(req))
+	defer span.End()
+
+	resp, err := s.ProviderServer.ApplyResourceChange(ctx, req)
+	if resp != nil {
+		setStatus(span, resp.Diagnostics)
+	}
+	recordError(span, err)
+	return resp, err
+}
+
+func (s *tracedServer) ImportResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	ctx, span := s.start(ctx, req.TypeName, "import")
+	defer span.End()
+	span.SetAttributes(ResourceIdKey.String(req.ID))
+
+	resp, err := s.ProviderServer.ImportResourceState(ctx, req)
+	if resp != nil {
+		setStatus(span, resp.Diagnostics)
+	}
+	recordError(span, err)
+	return resp, err
+}
+
+func (s *tracedServer) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	ctx, span := s.start(ctx, req.TypeName, "read")
+	defer span.End()
+
+	resp, err := s.ProviderServer.ReadDataSource(ctx, req)
+	if resp != nil {
+		setStatus(span, resp.Diagnostics)
+	}
+	recordError(span, err)
+	return resp, err
+}
+
+func (s *tracedServer) start(ctx context.Context, typeName, operation string) (context.Context, trace.Span) {
+	return s.tracer.Start(ctx, typeName+" "+operation, trace.WithAttributes(
+		ResourceTypeKey.String(typeName),
+		ResourceOperationKey.String(operation),
+	))
+}
+
+// SetResourceAttributes adds the identity of the resource to the span of the
+// current operation, once the resource knows it.
+func SetResourceAttributes(ctx context.Context, id, name, namespace string) {
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return
+	}
+
+	span.SetAttributes(
+		ResourceIdKey.String(id),
+		ResourceNameKey.String(name),
+		ResourceNamespaceKey.String(namespace),
+	)
+}
+
+// applyOperation tells creates and deletes, which have no prior or no planned
+// state, from updates.
+func applyOperation(req *tfprotov6.ApplyResourceChangeRequest) string {
+	if req.PriorState == nil {
+		return "create"
+	}
+	if null, err := req.PriorState.IsNull(); err == nil && null {
+		return "create"
+	}
+	if req.PlannedState == nil {
+		return "delete"
+	}
+	if null, err := req.PlannedState.IsNull(); err == nil && null {
+		return "delete"
+	}
+	return "update"
+}
+
+// setStatus marks the span as failed if the operation reported an error.
+func setStatus(span trace.Span, diagnostics []*tfprotov6.Diagnostic) {
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Severity == tfprotov6.DiagnosticSeverityError {
+			span.SetStatus(codes.Error, diagnostic.Summary)
+			return
+		}
+	}
+}
+
+func recordError(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
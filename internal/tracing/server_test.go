@@ -0,0 +1,139 @@
+package tracing_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/tracing"
+)
+
+var stateType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String}}
+
+// stubServer applies every change and reports the diagnostics it was given.
+type stubServer struct {
+	tfprotov6.ProviderServer
+	diagnostics []*tfprotov6.Diagnostic
+}
+
+func (s *stubServer) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	tracing.SetResourceAttributes(ctx, "id", "name", "namespace")
+	return &tfprotov6.ApplyResourceChangeResponse{NewState: req.PlannedState, Diagnostics: s.diagnostics}, nil
+}
+
+func state(t *testing.T, name *string) *tfprotov6.DynamicValue {
+	value := tftypes.NewValue(stateType, nil)
+	if name != nil {
+		value = tftypes.NewValue(stateType, map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, *name)})
+	}
+
+	dynamicValue, err := tfprotov6.NewDynamicValue(stateType, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &dynamicValue
+}
+
+func TestProviderServerTracesApply(t *testing.T) {
+	before, after := "before", "after"
+
+	testCases := []struct {
+		name         string
+		prior        *string
+		planned      *string
+		expectedSpan string
+	}{
+		{name: "create", planned: &after, expectedSpan: "cosmo_subgraph create"},
+		{name: "update", prior: &before, planned: &after, expectedSpan: "cosmo_subgraph update"},
+		{name: "delete", prior: &before, expectedSpan: "cosmo_subgraph delete"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			exporter := tracetest.NewInMemoryExporter()
+			tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+			server := tracing.ProviderServer(&stubServer{}, tracerProvider)
+
+			_, err := server.ApplyResourceChange(context.Background(), &tfprotov6.ApplyResourceChangeRequest{
+				TypeName:     "cosmo_subgraph",
+				PriorState:   state(t, tc.prior),
+				PlannedState: state(t, tc.planned),
+			})
+			if err != nil {
+				t.Fatalf("Expected the change to be applied but got error: %v", err)
+			}
+
+			spans := exporter.GetSpans()
+			if len(spans) != 1 {
+				t.Fatalf("Expected one span, got: %d", len(spans))
+			}
+			if spans[0].Name != tc.expectedSpan {
+				t.Errorf("Expected span %s, got: %s", tc.expectedSpan, spans[0].Name)
+			}
+
+			attributes := map[string]string{}
+			for _, attr := range spans[0].Attributes {
+				attributes[string(attr.Key)] = attr.Value.Emit()
+			}
+			if attributes[string(tracing.ResourceTypeKey)] != "cosmo_subgraph" {
+				t.Errorf("Expected resource type cosmo_subgraph, got: %q", attributes[string(tracing.ResourceTypeKey)])
+			}
+			if attributes[string(tracing.ResourceNameKey)] != "name" || attributes[string(tracing.ResourceNamespaceKey)] != "namespace" {
+				t.Errorf("Expected the name and namespace of the resource, got: %v", attributes)
+			}
+		})
+	}
+}
+
+func TestProviderServerRecordsErrors(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	server := tracing.ProviderServer(&stubServer{diagnostics: []*tfprotov6.Diagnostic{{
+		Severity: tfprotov6.DiagnosticSeverityError,
+		Summary:  "Error Creating Subgraph",
+	}}}, tracerProvider)
+
+	name := "name"
+	if _, err := server.ApplyResourceChange(context.Background(), &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:     "cosmo_subgraph",
+		PriorState:   state(t, nil),
+		PlannedState: state(t, &name),
+	}); err != nil {
+		t.Fatalf("Expected the change to be applied but got error: %v", err)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("Expected one span, got: %d", len(spans))
+	}
+	if spans[0].Status.Code != codes.Error || spans[0].Status.Description != "Error Creating Subgraph" {
+		t.Errorf("Expected the error to be recorded, got: %v", spans[0].Status)
+	}
+}
+
+func TestSetupWithoutEndpoint(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+	t.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "")
+
+	shutdown, err := tracing.Setup(context.Background(), "test")
+	if err != nil {
+		t.Fatalf("Expected tracing to be disabled but got error: %v", err)
+	}
+	if err := shutdown(context.Background()); err != nil {
+		t.Errorf("Expected shutdown to succeed but got error: %v", err)
+	}
+}
+
+func TestSetupRejectsUnknownProtocol(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://localhost:4318")
+	t.Setenv("OTEL_EXPORTER_OTLP_PROTOCOL", "http/json")
+
+	if _, err := tracing.Setup(context.Background(), "test"); err == nil {
+		t.Error("Expected an unsupported protocol to be rejected")
+	}
+}
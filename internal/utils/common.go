@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/tracing"
 )
 
 const (
@@ -111,7 +113,10 @@ func AddDiagnosticWarning(resp interface{}, title, message string) {
 	}
 }
 
+// LogAction logs the action and adds the identity of the resource to the span
+// of the current operation.
 func LogAction(ctx context.Context, action, resourceID, name, namespace string) {
+	tracing.SetResourceAttributes(ctx, resourceID, name, namespace)
 	tflog.Trace(ctx, action+" federated graph resource", map[string]interface{}{
 		"id":        resourceID,
 		"name":      name,
@@ -6,7 +6,12 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
+	"go.opentelemetry.io/otel"
+
 	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/provider"
+	"github.com/wundergraph/cosmo/terraform-provider-cosmo/internal/tracing"
 )
 
 // Run "go generate" to format example terraform files and generate the docs for the registry/website
@@ -34,15 +39,27 @@ func main() {
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	opts := providerserver.ServeOpts{
-		// TODO: Update this string with the published name of your provider.
-		// Also update the tfplugindocs generate command to either remove the
-		// -provider-name flag or set its value to the updated provider name.
-		Address: "registry.terraform.io/wundergraph/cosmo",
-		Debug:   debug,
+	ctx := context.Background()
+
+	shutdownTracing, err := tracing.Setup(ctx, version)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	var opts []tf6server.ServeOpt
+	if debug {
+		opts = append(opts, tf6server.WithManagedDebug())
 	}
 
-	err := providerserver.Serve(context.Background(), provider.New(version), opts)
+	// The provider server is served directly instead of through
+	// providerserver.Serve, so that its operations can be traced.
+	err = tf6server.Serve("registry.terraform.io/wundergraph/cosmo", func() tfprotov6.ProviderServer {
+		return tracing.ProviderServer(providerserver.NewProtocol6(provider.New(version)())(), otel.GetTracerProvider())
+	}, opts...)
+
+	if shutdownErr := shutdownTracing(ctx); shutdownErr != nil {
+		log.Print(shutdownErr.Error())
+	}
 
 	if err != nil {
 		log.Fatal(err.Error())